@@ -39,10 +39,14 @@ require (
 	github.com/aws/aws-sdk-go v1.42.20
 	github.com/google/go-cmp v0.5.6
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.8.0
+	github.com/modern-go/reflect2 v1.0.2 // indirect
+	golang.org/x/oauth2 v0.0.0-20210514164344-f6687ab2804c
 	golang.org/x/tools v0.1.7
+	google.golang.org/api v0.45.0
 	k8s.io/api v0.21.3
 	k8s.io/apimachinery v0.22.1
 	k8s.io/client-go v0.22.0
 	k8s.io/klog v1.0.0
 	k8s.io/kops v1.21.1
+	k8s.io/kubectl v0.21.0
 )
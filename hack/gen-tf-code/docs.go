@@ -25,16 +25,25 @@ func readHeader(path string, nullable bool) string {
 }
 
 var (
-	resourceClusterHeader        = readHeader("hack/gen-tf-code/docs/resource-cluster-header.md", true)
-	resourceClusterFooter        = readFile("hack/gen-tf-code/docs/resource-cluster-footer.md")
-	resourceClusterUpdaterHeader = readHeader("hack/gen-tf-code/docs/resource-cluster-updater-header.md", false)
-	resourceInstanceGroupHeader  = readHeader("hack/gen-tf-code/docs/resource-instance-group-header.md", true)
-	resourceInstanceGroupFooter  = readFile("hack/gen-tf-code/docs/resource-instance-group-footer.md")
-	dataClusterHeader            = readHeader("hack/gen-tf-code/docs/data-cluster-header.md", true)
-	dataClusterStatusHeader      = readHeader("hack/gen-tf-code/docs/data-cluster-status-header.md", false)
-	dataInstanceGroupHeader      = readHeader("hack/gen-tf-code/docs/data-instance-group-header.md", true)
-	dataKubeConfigHeader         = readHeader("hack/gen-tf-code/docs/data-kube-config-header.md", false)
-	configProviderHeader         = readHeader("hack/gen-tf-code/docs/config-provider-header.md", true)
+	resourceClusterHeader         = readHeader("hack/gen-tf-code/docs/resource-cluster-header.md", true)
+	resourceClusterFooter         = readFile("hack/gen-tf-code/docs/resource-cluster-footer.md")
+	resourceClusterUpdaterHeader  = readHeader("hack/gen-tf-code/docs/resource-cluster-updater-header.md", false)
+	resourceInstanceGroupHeader   = readHeader("hack/gen-tf-code/docs/resource-instance-group-header.md", true)
+	resourceInstanceGroupFooter   = readFile("hack/gen-tf-code/docs/resource-instance-group-footer.md")
+	resourceKeypairHeader         = readHeader("hack/gen-tf-code/docs/resource-keypair-header.md", false)
+	resourceKeypairFooter         = readFile("hack/gen-tf-code/docs/resource-keypair-footer.md")
+	resourceSecretHeader          = readHeader("hack/gen-tf-code/docs/resource-secret-header.md", false)
+	resourceSecretFooter          = readFile("hack/gen-tf-code/docs/resource-secret-footer.md")
+	dataClusterHeader             = readHeader("hack/gen-tf-code/docs/data-cluster-header.md", true)
+	dataClusterStatusHeader       = readHeader("hack/gen-tf-code/docs/data-cluster-status-header.md", false)
+	dataClusterChangesHeader      = readHeader("hack/gen-tf-code/docs/data-cluster-changes-header.md", false)
+	dataClustersHeader            = readHeader("hack/gen-tf-code/docs/data-clusters-header.md", false)
+	dataInstanceGroupHeader       = readHeader("hack/gen-tf-code/docs/data-instance-group-header.md", true)
+	dataInstanceGroupsHeader      = readHeader("hack/gen-tf-code/docs/data-instance-groups-header.md", true)
+	dataInstancesHeader           = readHeader("hack/gen-tf-code/docs/data-instances-header.md", false)
+	dataRollingUpdateStatusHeader = readHeader("hack/gen-tf-code/docs/data-rolling-update-status-header.md", false)
+	dataKubeConfigHeader          = readHeader("hack/gen-tf-code/docs/data-kube-config-header.md", false)
+	configProviderHeader          = readHeader("hack/gen-tf-code/docs/config-provider-header.md", true)
 )
 
 func getSubResources(t reflect.Type, seen map[reflect.Type]bool, isExcluded func(in _field) bool) []reflect.Type {
@@ -100,6 +100,9 @@ func optionFuncs(dataSource bool, optionsMap map[reflect.Type]*options, parser *
 		"suppressDiff": func(in _field) bool {
 			return optionsMap[in.Owner].suppressDiff.Has(in.Name)
 		},
+		"stringIn": func(in _field) []string {
+			return optionsMap[in.Owner].stringIn[in.Name]
+		},
 		"fieldName": func(in _field) string {
 			if optionsMap[in.Owner].rename[in.Name] != "" {
 				return fieldName(optionsMap[in.Owner].rename[in.Name])
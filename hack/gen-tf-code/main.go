@@ -98,10 +98,11 @@ func main() {
 		parser,
 		generate(resources.Cluster{},
 			version(2),
-			required("Name", "AdminSshKey"),
+			required("Name"),
 			computedOnly("Revision"),
-			sensitive("AdminSshKey"),
+			sensitive("AdminSshKey", "AdminSshKeys"),
 			forceNew("Name"),
+			stringIn("DeleteMode", utils.DeleteModeDestroy, utils.DeleteModeStateStoreOnly, utils.DeleteModeOrphan),
 			doc(resourceClusterHeader, resourceClusterFooter),
 		),
 		generate(resources.InstanceGroup{},
@@ -109,13 +110,32 @@ func main() {
 			required("ClusterName", "Name"),
 			forceNew("ClusterName", "Name"),
 			computedOnly("Revision"),
+			stringIn("DeleteMode", utils.DeleteModeDestroy, utils.DeleteModeStateStoreOnly, utils.DeleteModeOrphan),
 			doc(resourceInstanceGroupHeader, resourceInstanceGroupFooter),
 		),
+		generate(utils.InstanceGroupDeleteOptions{}),
 		generate(resources.ClusterUpdater{},
 			required("ClusterName"),
-			computedOnly("Revision"),
+			computedOnly("Revision", "NeedsApply", "NeedsRollingUpdate"),
 			doc(resourceClusterUpdaterHeader, ""),
 		),
+		generate(resources.Keypair{},
+			required("ClusterName", "Name", "Cert", "Key"),
+			forceNew("ClusterName", "Name"),
+			computedOnly("Revision", "PrimaryId", "Keys"),
+			sensitive("Key"),
+			doc(resourceKeypairHeader, resourceKeypairFooter),
+		),
+		generate(resources.KeysetItem{},
+			computedOnly("Id", "Cert", "Primary"),
+		),
+		generate(resources.Secret{},
+			required("ClusterName", "Name"),
+			forceNew("ClusterName", "Name"),
+			computedOnly("Revision"),
+			sensitive("Data", "DataBase64"),
+			doc(resourceSecretHeader, resourceSecretFooter),
+		),
 		generate(utils.RollingUpdateOptions{},
 			noSchema(),
 		),
@@ -127,12 +147,15 @@ func main() {
 			noSchema(),
 		),
 		generate(resources.ApplyOptions{}),
+		generate(utils.ApplyOptions{},
+			noSchema(),
+		),
 		generate(kops.ClusterSpec{},
 			noSchema(),
 			exclude("GossipConfig", "DNSControllerGossipConfig", "Target"),
 			rename("Subnets", "Subnet"),
 			rename("EtcdClusters", "EtcdCluster"),
-			required("CloudProvider", "Subnets", "NetworkID", "Topology", "EtcdClusters", "Networking"),
+			required("CloudProvider", "Subnets", "Topology", "EtcdClusters", "Networking"),
 			computed("MasterPublicName", "MasterInternalName", "ConfigBase", "NetworkCIDR", "NonMasqueradeCIDR", "IAM"),
 		),
 		generate(kops.InstanceMetadataOptions{}),
@@ -214,7 +237,7 @@ func main() {
 			required("Name"),
 		),
 		generate(kops.ClusterSubnetSpec{},
-			required("Name", "ProviderID", "Type", "Zone"),
+			required("Name", "Type", "Zone"),
 			computed("CIDR"),
 		),
 		generate(kops.TopologySpec{},
@@ -300,11 +323,24 @@ func main() {
 		parser,
 		generate(config.Provider{},
 			required("StateStore"),
+			rename("DigitalOcean", "Digitalocean"),
 			doc(configProviderHeader, ""),
 		),
 		generate(config.Aws{}),
-		generate(config.AwsAssumeRole{}),
+		generate(config.AwsAssumeRole{},
+			sensitive("WebIdentityToken"),
+		),
+		generate(config.AwsEndpoints{}),
 		generate(config.Openstack{}),
+		generate(config.Gce{},
+			sensitive("Credentials"),
+		),
+		generate(config.DigitalOcean{},
+			sensitive("AccessToken", "SpacesSecretKey"),
+		),
+		generate(config.Azure{},
+			sensitive("ClientSecret", "StorageKey"),
+		),
 		generate(config.Klog{},
 			nullable("Verbosity"),
 		),
@@ -322,17 +358,54 @@ func main() {
 			required("ClusterName"),
 			doc(dataClusterStatusHeader, ""),
 		),
+		generate(utils.ValidationFailure{}),
+		generate(utils.ValidationNode{}),
+		generate(datasources.ClusterChanges{},
+			required("ClusterName"),
+			computed("AllowKopsDowngrade"),
+			doc(dataClusterChangesHeader, ""),
+		),
+		generate(utils.ClusterChange{}),
+		generate(utils.ClusterChangeField{}),
+		generate(datasources.Clusters{},
+			computed("NamePrefix", "Labels"),
+			doc(dataClustersHeader, ""),
+		),
+		generate(datasources.ClusterSummary{}),
 		generate(resources.Cluster{},
 			version(2),
 			required("Name"),
-			exclude("Revision"),
+			exclude("Revision", "DeleteMode"),
 			doc(dataClusterHeader, ""),
 		),
+		generate(datasources.InstanceGroup{},
+			version(2),
+			computed("IncludeCloudStatus"),
+			doc(dataInstanceGroupHeader, ""),
+		),
 		generate(resources.InstanceGroup{},
 			version(2),
 			required("ClusterName", "Name"),
-			exclude("Revision"),
-			doc(dataInstanceGroupHeader, ""),
+			exclude("Revision", "DeleteMode", "Delete"),
+		),
+		generate(utils.InstanceGroupCloudStatus{}),
+		generate(utils.InstanceGroupCloudInstance{}),
+		generate(datasources.Instances{},
+			required("ClusterName"),
+			doc(dataInstancesHeader, ""),
+		),
+		generate(utils.Instance{}),
+		generate(datasources.RollingUpdateStatus{},
+			required("ClusterName"),
+			doc(dataRollingUpdateStatusHeader, ""),
+		),
+		generate(utils.RollingUpdateProgress{},
+			noSchema(),
+		),
+		generate(datasources.InstanceGroups{},
+			required("ClusterName"),
+			computed("Roles", "Subnets", "Zones"),
+			doc(dataInstanceGroupsHeader, ""),
 		),
 		generate(resources.ClusterSecrets{},
 			sensitive("DockerConfig"),
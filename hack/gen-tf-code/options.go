@@ -23,6 +23,7 @@ type options struct {
 	forceNew     sets.String
 	sensitive    sets.String
 	suppressDiff sets.String
+	stringIn     map[string][]string
 	doc          *optionsDoc
 }
 
@@ -37,6 +38,7 @@ func newOptions() *options {
 		forceNew:     sets.NewString(),
 		sensitive:    sets.NewString(),
 		suppressDiff: sets.NewString(),
+		stringIn:     make(map[string][]string),
 	}
 }
 
@@ -106,6 +108,12 @@ func suppressDiff(suppressDiff ...string) func(o *options) {
 	}
 }
 
+func stringIn(field string, values ...string) func(o *options) {
+	return func(o *options) {
+		o.stringIn[field] = values
+	}
+}
+
 func doc(header, footer string) func(o *options) {
 	return func(o *options) {
 		o.doc = &optionsDoc{
@@ -137,6 +145,11 @@ func (o *options) verify(t reflect.Type) error {
 	if err := verifyFields(t, o.suppressDiff.List()...); err != nil {
 		return err
 	}
+	for k := range o.stringIn {
+		if err := verifyFields(t, k); err != nil {
+			return err
+		}
+	}
 	for k := range o.rename {
 		if err := verifyFields(t, k); err != nil {
 			return err
@@ -66,6 +66,8 @@ func {{ scope }}{{ .Name }}() *schema.Resource {
 			{{- $forceNew := forceNew . -}}
 			{{- $sensitive := isSensitive . -}}
 			{{- $suppressDiff := suppressDiff . -}}
+			{{- $stringIn := stringIn . -}}
+			{{- if $stringIn -}}StringIn([]string{ {{- range $i, $v := $stringIn }}{{ if $i }}, {{ end }}{{ $v | quote }}{{ end -}} }, {{- end -}}
 			{{- if $suppressDiff -}}SuppressDiff({{- end -}}
 			{{- if $forceNew -}}ForceNew({{- end -}}
 			{{- if $sensitive -}}Sensitive({{- end -}}
@@ -85,6 +87,7 @@ func {{ scope }}{{ .Name }}() *schema.Resource {
 			)
 			{{- end -}}
 			{{- if $suppressDiff -}}){{- end -}}
+			{{- if $stringIn -}}){{- end -}}
 			{{- if $forceNew -}}){{- end -}}
 			{{- if $sensitive -}}){{- end -}}
 			,
@@ -21,6 +21,8 @@ type Aws struct {
 	S3SecretKey string
 	// SkipRegionCheck skips validating region check
 	SkipRegionCheck bool
+	// Endpoints defines custom AWS service endpoints
+	Endpoints *AwsEndpoints
 	// SkipRequestingAccountId   bool
 	// SkipCredentialsValidation bool
 }
@@ -1,6 +1,29 @@
 package config
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type AwsAssumeRole struct {
 	// RoleArn defines the arn of the AWS IAM role to assume
 	RoleArn string
+	// ExternalId defines the external id to use when assuming the role
+	ExternalId string
+	// SessionName defines the name of the role session (defaults to TF-PROVIDER-KOPS)
+	SessionName string
+	// Duration defines the duration of the role session, credentials are refreshed before they expire
+	Duration *metav1.Duration
+	// Policy defines an IAM policy in JSON format further restricting the permissions of the role session
+	Policy string
+	// PolicyArns defines the arns of managed IAM policies further restricting the permissions of the role session
+	PolicyArns []string
+	// Tags defines the tags attached to the role session
+	Tags map[string]string
+	// SourceIdentity defines the source identity of the role session
+	SourceIdentity string
+	// WebIdentityToken defines an OIDC token used to assume the role with web identity
+	WebIdentityToken string
+	// WebIdentityTokenFile defines the path of a file containing an OIDC token used to assume the role with web identity,
+	// the file is read again every time credentials are refreshed
+	WebIdentityTokenFile string
 }
@@ -0,0 +1,20 @@
+package config
+
+type AwsEndpoints struct {
+	// Ec2 defines a custom EC2 endpoint
+	Ec2 string
+	// Iam defines a custom IAM endpoint
+	Iam string
+	// Elb defines a custom ELB endpoint
+	Elb string
+	// Elbv2 defines a custom ELBv2 endpoint
+	Elbv2 string
+	// Route53 defines a custom Route53 endpoint
+	Route53 string
+	// Autoscaling defines a custom AutoScaling endpoint
+	Autoscaling string
+	// Sts defines a custom STS endpoint
+	Sts string
+	// Sqs defines a custom SQS endpoint
+	Sqs string
+}
@@ -0,0 +1,16 @@
+package config
+
+type Azure struct {
+	// TenantId defines the Azure Active Directory tenant id
+	TenantId string
+	// SubscriptionId defines the Azure subscription id
+	SubscriptionId string
+	// ClientId defines the client id of the service principal
+	ClientId string
+	// ClientSecret defines the client secret of the service principal
+	ClientSecret string
+	// StorageAccount defines the storage account hosting the state store
+	StorageAccount string
+	// StorageKey defines the access key of the storage account
+	StorageKey string
+}
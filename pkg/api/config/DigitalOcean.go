@@ -0,0 +1,12 @@
+package config
+
+type DigitalOcean struct {
+	// AccessToken defines the DigitalOcean API access token
+	AccessToken string
+	// SpacesEndpoint defines the endpoint of the spaces hosting the state store
+	SpacesEndpoint string
+	// SpacesAccessKey defines the access key used to access spaces
+	SpacesAccessKey string
+	// SpacesSecretKey defines the secret key used to access spaces
+	SpacesSecretKey string
+}
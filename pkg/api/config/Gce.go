@@ -0,0 +1,14 @@
+package config
+
+type Gce struct {
+	// Project defines the default GCP project
+	Project string
+	// Region defines the default GCP region
+	Region string
+	// Credentials defines the content of a service account key file in JSON format
+	Credentials string
+	// CredentialsFile defines the path of a service account key file
+	CredentialsFile string
+	// ImpersonateServiceAccount defines the email of a service account to impersonate
+	ImpersonateServiceAccount string
+}
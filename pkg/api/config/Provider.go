@@ -7,9 +7,15 @@ type Provider struct {
 	Aws *Aws
 	// OpenStack contains the openstack configuration options
 	Openstack *Openstack
+	// Gce contains the gce configuration options
+	Gce *Gce
+	// DigitalOcean contains the digitalocean configuration options
+	DigitalOcean *DigitalOcean
+	// Azure contains the azure configuration options
+	Azure *Azure
 	// Klog contains the klog configuration options
 	Klog *Klog
-	// Mock sets up a cloud mock for integration tests
+	// Mock sets up aws and gce cloud mocks for integration tests
 	Mock bool
 	// FeatureFlags contains feature flags to enable or disable
 	FeatureFlags []string
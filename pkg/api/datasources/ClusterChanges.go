@@ -0,0 +1,29 @@
+package datasources
+
+import (
+	"context"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// ClusterChanges reports the changes to cloud resources that would be made by applying a cluster
+type ClusterChanges struct {
+	// ClusterName defines the target cluster name
+	ClusterName string
+	// AllowKopsDowngrade allows computing changes with an older version of kOps than the one last used to apply the cluster
+	AllowKopsDowngrade bool
+	// NeedsApply indicates if applying the cluster would change cloud resources
+	NeedsApply bool
+	// Changes contains the changes that would be made to cloud resources
+	Changes []utils.ClusterChange
+}
+
+func (s *ClusterChanges) GetClusterChanges(ctx context.Context, clientset simple.Clientset) error {
+	if changes, err := utils.ClusterChanges(ctx, clientset, s.ClusterName, utils.ApplyOptions{AllowKopsDowngrade: s.AllowKopsDowngrade}); err != nil {
+		return err
+	} else {
+		s.NeedsApply = len(changes) != 0
+		s.Changes = changes
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package datasources
 
 import (
+	"context"
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	"k8s.io/kops/pkg/client/simple"
 )
@@ -13,24 +14,34 @@ type ClusterStatus struct {
 	Exists bool
 	// IsValid indicates if the cluster is valid
 	IsValid bool
+	// ValidationFailures contains the failures reported by the cluster validation
+	ValidationFailures []utils.ValidationFailure
+	// Nodes contains the nodes checked by the cluster validation
+	Nodes []utils.ValidationNode
 	// NeedsUpdate indicates if the cluster needs a rolling update
 	NeedsUpdate bool
 	// InstanceGroups contains the name of instance groups to be updated
 	InstanceGroups []string
 }
 
-func (s *ClusterStatus) GetClusterStatus(clientset simple.Clientset) error {
-	if exists, err := utils.ClusterExists(clientset, s.ClusterName); err != nil {
+func (s *ClusterStatus) GetClusterStatus(ctx context.Context, clientset simple.Clientset) error {
+	if exists, err := utils.ClusterExists(ctx, clientset, s.ClusterName); err != nil {
 		return err
 	} else {
+		s.Exists = exists
 		if exists {
-			if isValid, err := utils.ClusterIsValid(clientset, s.ClusterName); err != nil {
+			if validation, err := utils.ClusterValidation(ctx, clientset, s.ClusterName); err != nil {
 				return err
 			} else {
-				s.IsValid = isValid
+				s.IsValid = validation.IsValid
+				s.ValidationFailures = validation.Failures
+				s.Nodes = validation.Nodes
 			}
-			if needsUpdate, err := utils.ClusterInstanceGroupsNeedingUpdate(clientset, s.ClusterName); err != nil {
-				return err
+			// instance groups needing update cannot be determined when the cluster is unreachable,
+			// this is reported as a validation failure like other validation errors
+			if needsUpdate, err := utils.ClusterInstanceGroupsNeedingUpdate(ctx, clientset, s.ClusterName, nil, nil); err != nil {
+				s.IsValid = false
+				s.ValidationFailures = append(s.ValidationFailures, utils.ClusterFailure(s.ClusterName, "unable to check instance groups needing update", err))
 			} else {
 				s.NeedsUpdate = len(needsUpdate) != 0
 				s.InstanceGroups = needsUpdate
@@ -0,0 +1,55 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// testClientset returns a clientset backed by an in memory state store containing a minimal aws cluster
+func testClientset(t *testing.T, clusterName string) simple.Clientset {
+	cloud := awsup.InstallMockAWSCloud("us-mock-1", "abc")
+	cloud.MockEC2 = &mockec2.MockEC2{}
+	cloud.MockAutoscaling = &mockautoscaling.MockAutoscaling{}
+	ctx := context.Background()
+	clientset := vfsclientset.NewVFSClientset(vfs.NewMemFSPath(vfs.NewMemFSContext(), "state"))
+	cluster, err := clientset.CreateCluster(ctx, testutils.BuildMinimalCluster(clusterName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-mock-1a")
+	if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	return clientset
+}
+
+func TestGetClusterStatusUnreachable(t *testing.T) {
+	clientset := testClientset(t, "cluster.example.com")
+	status := ClusterStatus{ClusterName: "cluster.example.com"}
+	if err := status.GetClusterStatus(context.Background(), clientset); err != nil {
+		t.Fatalf("an unreachable cluster must not be an error: %v", err)
+	}
+	if !status.Exists {
+		t.Error("expected cluster to exist")
+	}
+	if status.IsValid || status.NeedsUpdate {
+		t.Errorf("expected cluster to be invalid and not needing update, got %+v", status)
+	}
+	if len(status.ValidationFailures) == 0 {
+		t.Fatal("expected validation failures")
+	}
+	for _, failure := range status.ValidationFailures {
+		if failure.Kind != "Cluster" || failure.Name != "cluster.example.com" || failure.Message == "" {
+			t.Errorf("unexpected validation failure %+v", failure)
+		}
+	}
+}
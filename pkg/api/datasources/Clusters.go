@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// Clusters lists the clusters in the state store
+type Clusters struct {
+	// NamePrefix filters clusters by name prefix
+	NamePrefix string
+	// Labels filters clusters by labels, all labels must match
+	Labels map[string]string
+	// Clusters contains the clusters matching the filters
+	Clusters []ClusterSummary
+}
+
+// ClusterSummary summarizes a cluster in the state store
+type ClusterSummary struct {
+	// Name is the cluster name
+	Name string
+	// KubernetesVersion is the cluster kubernetes version
+	KubernetesVersion string
+	// CloudProvider is the cluster cloud provider
+	CloudProvider string
+	// CreationTimestamp is the cluster creation timestamp (RFC3339)
+	CreationTimestamp string
+	// InstanceGroups contains the names of the cluster instance groups
+	InstanceGroups []string
+}
+
+func (s *Clusters) GetClusters(ctx context.Context, clientset simple.Clientset) error {
+	list, err := clientset.ListClusters(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	selector := labels.SelectorFromSet(s.Labels)
+	s.Clusters = nil
+	for i := range list.Items {
+		cluster := &list.Items[i]
+		if !strings.HasPrefix(cluster.ObjectMeta.Name, s.NamePrefix) || !selector.Matches(labels.Set(cluster.ObjectMeta.Labels)) {
+			continue
+		}
+		summary := ClusterSummary{
+			Name:              cluster.ObjectMeta.Name,
+			KubernetesVersion: cluster.Spec.KubernetesVersion,
+			CloudProvider:     cluster.Spec.CloudProvider,
+		}
+		if !cluster.ObjectMeta.CreationTimestamp.IsZero() {
+			summary.CreationTimestamp = cluster.ObjectMeta.CreationTimestamp.UTC().Format(time.RFC3339)
+		}
+		instanceGroups, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, instanceGroup := range instanceGroups.Items {
+			summary.InstanceGroups = append(summary.InstanceGroups, instanceGroup.ObjectMeta.Name)
+		}
+		s.Clusters = append(s.Clusters, summary)
+	}
+	return nil
+}
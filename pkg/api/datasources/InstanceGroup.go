@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// InstanceGroup represents a kops instance group, optionally with its state in the cloud
+type InstanceGroup struct {
+	resources.InstanceGroup
+	// IncludeCloudStatus enables reporting the state of the instance group in the cloud, it requires cloud credentials
+	IncludeCloudStatus bool
+	// CloudStatus reports the state of the instance group in the cloud when IncludeCloudStatus is true
+	CloudStatus *utils.InstanceGroupCloudStatus
+}
+
+func (s *InstanceGroup) GetInstanceGroup(ctx context.Context, clientset simple.Clientset) error {
+	instanceGroup, err := resources.GetInstanceGroup(ctx, s.ClusterName, s.Name, clientset)
+	if err != nil {
+		return err
+	}
+	s.InstanceGroup = *instanceGroup
+	s.CloudStatus = nil
+	if s.IncludeCloudStatus {
+		if s.CloudStatus, err = utils.InstanceGroupStatus(ctx, clientset, s.ClusterName, s.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetInstanceGroupCloudStatus(t *testing.T) {
+	clientset := testClientset(t, "cluster.example.com")
+	ig := InstanceGroup{}
+	ig.ClusterName = "cluster.example.com"
+	ig.Name = "nodes"
+	if err := ig.GetInstanceGroup(context.Background(), clientset); err != nil {
+		t.Fatal(err)
+	}
+	if ig.Role != "Node" || ig.ClusterName != "cluster.example.com" || ig.Name != "nodes" {
+		t.Errorf("unexpected instance group %+v", ig.InstanceGroup)
+	}
+	if ig.CloudStatus != nil {
+		t.Errorf("cloud status must not be reported unless requested, got %+v", ig.CloudStatus)
+	}
+	// the cluster kubernetes api is not reachable, node names cannot be resolved
+	ig.IncludeCloudStatus = true
+	if err := ig.GetInstanceGroup(context.Background(), clientset); err == nil {
+		t.Errorf("expected an error when the cluster is unreachable, got %+v", ig.CloudStatus)
+	}
+}
@@ -0,0 +1,80 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// InstanceGroups lists the instance groups of a cluster
+type InstanceGroups struct {
+	// ClusterName defines the target cluster name
+	ClusterName string
+	// Roles filters instance groups by role (Master, Node, Bastion, ...)
+	Roles []string
+	// Subnets filters instance groups running in at least one of the given subnets
+	Subnets []string
+	// Zones filters instance groups running in at least one of the given zones
+	Zones []string
+	// InstanceGroups contains the instance groups matching the filters
+	InstanceGroups []resources.InstanceGroup
+}
+
+func (s *InstanceGroups) GetInstanceGroups(ctx context.Context, clientset simple.Clientset) error {
+	cluster, err := clientset.GetCluster(ctx, s.ClusterName)
+	if err != nil {
+		return err
+	}
+	roles := map[kops.InstanceGroupRole]bool{}
+	for _, role := range s.Roles {
+		r, ok := kops.ParseInstanceGroupRole(role, true)
+		if !ok {
+			return fmt.Errorf("invalid instance group role %q", role)
+		}
+		roles[r] = true
+	}
+	subnetZones := map[string]string{}
+	for _, subnet := range cluster.Spec.Subnets {
+		subnetZones[subnet.Name] = subnet.Zone
+	}
+	instanceGroups, err := resources.ListInstanceGroups(ctx, s.ClusterName, clientset)
+	if err != nil {
+		return err
+	}
+	s.InstanceGroups = nil
+	for _, instanceGroup := range instanceGroups {
+		if len(roles) != 0 && !roles[instanceGroup.Role] {
+			continue
+		}
+		if len(s.Subnets) != 0 && !intersects(s.Subnets, instanceGroup.Subnets) {
+			continue
+		}
+		if len(s.Zones) != 0 {
+			zones := instanceGroup.Zones
+			for _, subnet := range instanceGroup.Subnets {
+				if zone, ok := subnetZones[subnet]; ok && zone != "" {
+					zones = append(zones, zone)
+				}
+			}
+			if !intersects(s.Zones, zones) {
+				continue
+			}
+		}
+		s.InstanceGroups = append(s.InstanceGroups, instanceGroup)
+	}
+	return nil
+}
+
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
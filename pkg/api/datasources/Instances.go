@@ -0,0 +1,25 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// Instances lists the cloud instances of a cluster
+type Instances struct {
+	// ClusterName defines the target cluster name
+	ClusterName string
+	// Instances contains the cloud instances of the cluster
+	Instances []utils.Instance
+}
+
+func (s *Instances) GetInstances(ctx context.Context, clientset simple.Clientset) error {
+	if instances, err := utils.ClusterInstances(ctx, clientset, s.ClusterName); err != nil {
+		return err
+	} else {
+		s.Instances = instances
+	}
+	return nil
+}
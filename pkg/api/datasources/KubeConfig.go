@@ -1,6 +1,7 @@
 package datasources
 
 import (
+	"context"
 	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/kube"
@@ -18,8 +19,8 @@ type KubeConfig struct {
 	kube.Config
 }
 
-func (s *KubeConfig) GetKubeConfig(clientset simple.Clientset) error {
-	if err := s.Config.GetConfig(clientset, s.ClusterName, s.Admin, s.Internal); err != nil {
+func (s *KubeConfig) GetKubeConfig(ctx context.Context, clientset simple.Clientset) error {
+	if err := s.Config.GetConfig(ctx, clientset, s.ClusterName, s.Admin, s.Internal); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,27 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/kops/pkg/client/simple"
+)
+
+// RollingUpdateStatus reports the progress of the last rolling update of a cluster
+type RollingUpdateStatus struct {
+	// ClusterName defines the target cluster name
+	ClusterName string
+	// Exists indicates if a rolling update was recorded for the cluster
+	Exists bool
+	utils.RollingUpdateProgress
+}
+
+func (s *RollingUpdateStatus) GetRollingUpdateStatus(ctx context.Context, clientset simple.Clientset) error {
+	if progress, err := utils.ClusterRollingUpdateProgress(ctx, clientset, s.ClusterName); err != nil {
+		return err
+	} else if progress != nil {
+		s.Exists = true
+		s.RollingUpdateProgress = *progress
+	}
+	return nil
+}
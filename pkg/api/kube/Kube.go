@@ -1,6 +1,7 @@
 package kube
 
 import (
+	"context"
 	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
@@ -26,8 +27,8 @@ type Config struct {
 	ClientKey string
 }
 
-func (s *Config) GetConfig(clientset simple.Clientset, clusterName string, admin *time.Duration, internal bool) error {
-	conf, err := utils.GetKubeConfigBuilder(clientset, clusterName, admin, internal)
+func (s *Config) GetConfig(ctx context.Context, clientset simple.Clientset, clusterName string, admin *time.Duration, internal bool) error {
+	conf, err := utils.GetKubeConfigBuilder(ctx, clientset, clusterName, admin, internal)
 	if err != nil {
 		return err
 	}
@@ -3,7 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/client/simple"
@@ -22,30 +27,45 @@ type Cluster struct {
 	Name string
 	// AdminSshKey defines the cluster admin ssh key
 	AdminSshKey string
+	// AdminSshKeys defines additional cluster admin ssh keys
+	AdminSshKeys []string
 	// Secrets defines the cluster secret
 	Secrets *ClusterSecrets
+	// DeletionProtection prevents the cluster from being deleted, it is stored as an annotation in the state store
+	DeletionProtection bool
+	// DeleteMode defines what happens when the cluster is deleted (destroy, state_store_only or orphan), defaults to destroy
+	DeleteMode string
 }
 
-func makeCluster(adminSshKey string, secrets *ClusterSecrets, cluster *kops.Cluster) *Cluster {
-	return &Cluster{
-		ClusterSpec: cluster.Spec,
-		Name:        cluster.ObjectMeta.Name,
-		AdminSshKey: adminSshKey,
-		Secrets:     secrets,
-	}
+func makeCluster(adminSshKeys []string, secrets *ClusterSecrets, cluster *kops.Cluster) *Cluster {
+	c := &Cluster{
+		ClusterSpec:        cluster.Spec,
+		Name:               cluster.ObjectMeta.Name,
+		Secrets:            secrets,
+		DeletionProtection: utils.HasDeletionProtection(cluster.ObjectMeta),
+	}
+	if len(adminSshKeys) == 1 {
+		c.AdminSshKey = adminSshKeys[0]
+	} else {
+		c.AdminSshKeys = adminSshKeys
+	}
+	return c
 }
 
-func makeKopsCluster(name string, spec kops.ClusterSpec) *kops.Cluster {
-	return &kops.Cluster{
+func makeKopsCluster(name string, annotations map[string]string, deletionProtection bool, spec kops.ClusterSpec) *kops.Cluster {
+	kc := &kops.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:        name,
+			Annotations: annotations,
 		},
 		Spec: spec,
 	}
+	utils.SetDeletionProtection(&kc.ObjectMeta, deletionProtection)
+	return kc
 }
 
-func GetCluster(name string, clientset simple.Clientset) (*Cluster, error) {
-	kc, err := clientset.GetCluster(context.Background(), name)
+func GetCluster(ctx context.Context, name string, clientset simple.Clientset) (*Cluster, error) {
+	kc, err := clientset.GetCluster(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +73,7 @@ func GetCluster(name string, clientset simple.Clientset) (*Cluster, error) {
 	if err != nil {
 		return nil, err
 	}
-	pubKeys, err := sshCredentialStore.FindSSHPublicKeys(fi.SecretNameSSHPrimary)
+	adminSshKeys, err := getAdminSshKeys(sshCredentialStore)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +89,15 @@ func GetCluster(name string, clientset simple.Clientset) (*Cluster, error) {
 	if err != nil {
 		return nil, err
 	}
-	cluster := makeCluster(pubKeys[0].Spec.PublicKey, secrets, kc)
+	cluster := makeCluster(adminSshKeys, secrets, kc)
 	return cluster, nil
 }
 
-func CreateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.ClusterSpec, clientset simple.Clientset) (*Cluster, error) {
-	kc := makeKopsCluster(name, spec)
+func CreateCluster(ctx context.Context, name, adminSshKey string, adminSshKeys []string, secrets *ClusterSecrets, spec kops.ClusterSpec, deletionProtection bool, clientset simple.Clientset) (*Cluster, error) {
+	if err := CheckAdminSshKeys(spec.CloudProvider, adminSshKey, adminSshKeys); err != nil {
+		return nil, err
+	}
+	kc := makeKopsCluster(name, nil, deletionProtection, spec)
 	cloud, err := cloudup.BuildCloud(kc)
 	if err != nil {
 		return nil, err
@@ -82,11 +105,11 @@ func CreateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err := cloudup.PerformAssignments(kc, cloud); err != nil {
 		return nil, err
 	}
-	kc, err = clientset.CreateCluster(context.Background(), kc)
+	kc, err = clientset.CreateCluster(ctx, kc)
 	if err != nil {
 		return nil, err
 	}
-	kc, err = clientset.GetCluster(context.Background(), name)
+	kc, err = clientset.GetCluster(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +117,8 @@ func CreateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err != nil {
 		return nil, err
 	}
-	if err = sshCredentialStore.AddSSHPublicKey(fi.SecretNameSSHPrimary, []byte(adminSshKey)); err != nil {
-		return nil, fmt.Errorf("error adding SSH public key: %v", err)
+	if err := updateAdminSshKeys(sshCredentialStore, mergeAdminSshKeys(adminSshKey, adminSshKeys)); err != nil {
+		return nil, err
 	}
 	secretStore, err := clientset.SecretStore(kc)
 	if err != nil {
@@ -109,15 +132,22 @@ func CreateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err != nil {
 		return nil, err
 	}
-	kc, err = clientset.GetCluster(context.Background(), name)
+	kc, err = clientset.GetCluster(ctx, name)
 	if err != nil {
 		return nil, err
 	}
-	return makeCluster(adminSshKey, secrets, kc), nil
+	return makeCluster(mergeAdminSshKeys(adminSshKey, adminSshKeys), secrets, kc), nil
 }
 
-func UpdateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.ClusterSpec, clientset simple.Clientset) (*Cluster, error) {
-	kc := makeKopsCluster(name, spec)
+func UpdateCluster(ctx context.Context, name, adminSshKey string, adminSshKeys []string, secrets *ClusterSecrets, spec kops.ClusterSpec, deletionProtection bool, clientset simple.Clientset) (*Cluster, error) {
+	if err := CheckAdminSshKeys(spec.CloudProvider, adminSshKey, adminSshKeys); err != nil {
+		return nil, err
+	}
+	kc, err := clientset.GetCluster(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	kc = makeKopsCluster(name, kc.ObjectMeta.Annotations, deletionProtection, spec)
 	cloud, err := cloudup.BuildCloud(kc)
 	if err != nil {
 		return nil, err
@@ -125,7 +155,7 @@ func UpdateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err := cloudup.PerformAssignments(kc, cloud); err != nil {
 		return nil, err
 	}
-	kc, err = clientset.UpdateCluster(context.Background(), kc, nil)
+	kc, err = clientset.UpdateCluster(ctx, kc, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -133,8 +163,8 @@ func UpdateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err != nil {
 		return nil, err
 	}
-	if err = sshCredentialStore.AddSSHPublicKey(fi.SecretNameSSHPrimary, []byte(adminSshKey)); err != nil {
-		return nil, fmt.Errorf("error adding SSH public key: %v", err)
+	if err := updateAdminSshKeys(sshCredentialStore, mergeAdminSshKeys(adminSshKey, adminSshKeys)); err != nil {
+		return nil, err
 	}
 	secretStore, err := clientset.SecretStore(kc)
 	if err != nil {
@@ -148,26 +178,148 @@ func UpdateCluster(name, adminSshKey string, secrets *ClusterSecrets, spec kops.
 	if err != nil {
 		return nil, err
 	}
-	kc, err = clientset.GetCluster(context.Background(), name)
+	kc, err = clientset.GetCluster(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return makeCluster(mergeAdminSshKeys(adminSshKey, adminSshKeys), secrets, kc), nil
+}
+
+// ArrangeAdminSshKeys splits the admin ssh keys of the cluster between AdminSshKey and AdminSshKeys
+// the same way they are split in the given configuration, keeping the configuration order
+func ArrangeAdminSshKeys(cluster *Cluster, adminSshKey string, adminSshKeys []string) {
+	current := mergeAdminSshKeys(cluster.AdminSshKey, cluster.AdminSshKeys)
+	if adminSshKey == "" && len(adminSshKeys) == 0 {
+		return
+	}
+	cluster.AdminSshKey = ""
+	cluster.AdminSshKeys = nil
+	remaining := map[string]string{}
+	for _, key := range current {
+		remaining[strings.TrimSpace(key)] = key
+	}
+	if key, ok := remaining[strings.TrimSpace(adminSshKey)]; ok {
+		cluster.AdminSshKey = key
+		delete(remaining, strings.TrimSpace(adminSshKey))
+	}
+	for _, key := range adminSshKeys {
+		if key, ok := remaining[strings.TrimSpace(key)]; ok {
+			cluster.AdminSshKeys = append(cluster.AdminSshKeys, key)
+			delete(remaining, strings.TrimSpace(key))
+		}
+	}
+	for _, key := range current {
+		if _, ok := remaining[strings.TrimSpace(key)]; ok {
+			cluster.AdminSshKeys = append(cluster.AdminSshKeys, key)
+		}
+	}
+}
+
+func mergeAdminSshKeys(adminSshKey string, adminSshKeys []string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, key := range append([]string{adminSshKey}, adminSshKeys...) {
+		if strings.TrimSpace(key) == "" || seen[strings.TrimSpace(key)] {
+			continue
+		}
+		seen[strings.TrimSpace(key)] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// CheckAdminSshKeys rejects more than one admin ssh key on clouds where kops applies a single key to the instances
+func CheckAdminSshKeys(cloudProvider, adminSshKey string, adminSshKeys []string) error {
+	switch kops.CloudProviderID(cloudProvider) {
+	case kops.CloudProviderAWS, kops.CloudProviderALI, kops.CloudProviderAzure, kops.CloudProviderOpenstack:
+		if keys := mergeAdminSshKeys(adminSshKey, adminSshKeys); len(keys) > 1 {
+			return fmt.Errorf("exactly one admin ssh key can be specified when running with %s, got %d", cloudProvider, len(keys))
+		}
+	}
+	return nil
+}
+
+func getAdminSshKeys(sshCredentialStore fi.SSHCredentialStore) ([]string, error) {
+	pubKeys, err := sshCredentialStore.FindSSHPublicKeys(fi.SecretNameSSHPrimary)
 	if err != nil {
 		return nil, err
 	}
-	return makeCluster(adminSshKey, secrets, kc), nil
+	var keys []string
+	for _, pubKey := range pubKeys {
+		keys = append(keys, pubKey.Spec.PublicKey)
+	}
+	return keys, nil
 }
 
-func DeleteCluster(name string, clientset simple.Clientset) error {
-	kc, err := clientset.GetCluster(context.Background(), name)
+func updateAdminSshKeys(sshCredentialStore fi.SSHCredentialStore, adminSshKeys []string) error {
+	pubKeys, err := sshCredentialStore.FindSSHPublicKeys(fi.SecretNameSSHPrimary)
 	if err != nil {
 		return err
 	}
-	cloud, err := cloudup.BuildCloud(kc)
+	existing := map[string]bool{}
+	for _, pubKey := range pubKeys {
+		existing[strings.TrimSpace(pubKey.Spec.PublicKey)] = true
+	}
+	desired := map[string]bool{}
+	for _, key := range adminSshKeys {
+		desired[strings.TrimSpace(key)] = true
+		if !existing[strings.TrimSpace(key)] {
+			if err := sshCredentialStore.AddSSHPublicKey(fi.SecretNameSSHPrimary, []byte(key)); err != nil {
+				return fmt.Errorf("error adding SSH public key: %v", err)
+			}
+		}
+	}
+	for _, pubKey := range pubKeys {
+		if !desired[strings.TrimSpace(pubKey.Spec.PublicKey)] {
+			if err := sshCredentialStore.DeleteSSHCredential(pubKey); err != nil {
+				return fmt.Errorf("error deleting SSH public key: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func DeleteCluster(ctx context.Context, name, deleteMode string, clientset simple.Clientset) error {
+	deleteMode, err := utils.ParseDeleteMode(deleteMode)
 	if err != nil {
 		return err
 	}
-	allResources, err := ops.ListResources(cloud, kc, "")
+	if deleteMode == utils.DeleteModeOrphan {
+		return nil
+	}
+	kc, err := clientset.GetCluster(ctx, name)
+	if err != nil {
+		return err
+	}
+	if utils.HasDeletionProtection(kc.ObjectMeta) {
+		return fmt.Errorf("cluster %s has deletion protection enabled, set deletion_protection to false and apply before deleting it", name)
+	}
+	if deleteMode == utils.DeleteModeDestroy {
+		cloud, err := cloudup.BuildCloud(kc)
+		if err != nil {
+			return err
+		}
+		// keep the cluster in the state store until all its cloud resources are gone, a later attempt can finish the job
+		if err := deleteClusterResources(ctx, cloud, kc); err != nil {
+			return err
+		}
+	}
+	if err := utils.DeleteRollingUpdateProgress(clientset, kc); err != nil {
+		return err
+	}
+	err = clientset.DeleteCluster(ctx, kc)
 	if err != nil {
 		return err
 	}
+	return nil
+}
+
+// listClusterResources returns the cloud resources owned by the cluster
+func listClusterResources(cloud fi.Cloud, kc *kops.Cluster) (map[string]*resources.Resource, error) {
+	allResources, err := ops.ListResources(cloud, kc, "")
+	if err != nil {
+		return nil, err
+	}
 	clusterResources := make(map[string]*resources.Resource)
 	for k, resource := range allResources {
 		if resource.Shared {
@@ -175,19 +327,61 @@ func DeleteCluster(name string, clientset simple.Clientset) error {
 		}
 		clusterResources[k] = resource
 	}
-	if len(clusterResources) != 0 {
-		var l []*resources.Resource
-		for _, v := range clusterResources {
-			l = append(l, v)
+	return clusterResources, nil
+}
+
+// deleteClusterResources deletes the cloud resources owned by the cluster until none is left,
+// it retries with backoff until the context deadline and reports the remaining resources when giving up
+func deleteClusterResources(ctx context.Context, cloud fi.Cloud, kc *kops.Cluster) error {
+	clusterResources, err := listClusterResources(cloud, kc)
+	if err != nil {
+		return err
+	}
+	backoff := 10 * time.Second
+	for len(clusterResources) != 0 {
+		// ops.DeleteResources is not context aware, run it in the background to notice the deadline
+		done := make(chan error, 1)
+		go func(clusterResources map[string]*resources.Resource) {
+			done <- ops.DeleteResources(cloud, clusterResources)
+		}(clusterResources)
+		select {
+		case <-ctx.Done():
+			// the deletion pass cannot be cancelled, wait for it to finish before giving up so that no cloud
+			// resource gets deleted after the failure has been reported
+			log.Printf("deadline reached, waiting for the current deletion pass of cluster %s to finish\n", kc.Name)
+			<-done
+			if remaining, err := listClusterResources(cloud, kc); err == nil {
+				clusterResources = remaining
+			}
+			return remainingResourcesError(kc.Name, clusterResources, ctx.Err())
+		case err := <-done:
+			if err != nil {
+				log.Printf("(will retry): error deleting resources of cluster %s: %v\n", kc.Name, err)
+			}
 		}
-		err = ops.DeleteResources(cloud, clusterResources)
-		if err != nil {
+		if clusterResources, err = listClusterResources(cloud, kc); err != nil {
 			return err
 		}
-	}
-	err = clientset.DeleteCluster(context.Background(), kc)
-	if err != nil {
-		return err
+		if len(clusterResources) == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return remainingResourcesError(kc.Name, clusterResources, ctx.Err())
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > 2*time.Minute {
+			backoff = 2 * time.Minute
+		}
 	}
 	return nil
 }
+
+func remainingResourcesError(clusterName string, clusterResources map[string]*resources.Resource, err error) error {
+	var remaining []string
+	for _, resource := range clusterResources {
+		remaining = append(remaining, fmt.Sprintf("%s\t%s", resource.Type, resource.ID))
+	}
+	sort.Strings(remaining)
+	return fmt.Errorf("gave up deleting cluster %s (%v), it was kept in the state store, remaining resources:\n%s", clusterName, err, strings.Join(remaining, "\n"))
+}
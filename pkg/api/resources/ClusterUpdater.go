@@ -1,7 +1,11 @@
 package resources
 
 import (
+	"context"
+	"log"
+
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/kops/pkg/client/simple"
 )
 
@@ -19,22 +23,53 @@ type ClusterUpdater struct {
 	RollingUpdate RollingUpdateOptions
 	// Validate holds cluster validation options
 	Validate ValidateOptions
+	// NeedsApply indicates if applying the cluster would change cloud resources
+	NeedsApply bool
+	// NeedsRollingUpdate indicates if instance groups need a rolling update
+	NeedsRollingUpdate bool
 }
 
-func (u *ClusterUpdater) UpdateCluster(clientset simple.Clientset) error {
+func (u *ClusterUpdater) UpdateCluster(ctx context.Context, clientset simple.Clientset) error {
 	if !u.Apply.Skip {
-		if err := utils.ClusterApply(clientset, u.ClusterName, u.Apply.AllowKopsDowngrade); err != nil {
+		if err := utils.ClusterApply(ctx, clientset, u.ClusterName, u.Apply.ApplyOptions); err != nil {
 			return err
 		}
 	}
 	if !u.Validate.Skip {
-		if err := utils.ClusterValidate(clientset, u.ClusterName, u.Validate.ValidateOptions); err != nil {
+		if err := utils.ClusterValidate(ctx, clientset, u.ClusterName, u.Validate.ValidateOptions); err != nil {
+			return err
+		}
+	}
+	if !u.RollingUpdate.Skip {
+		if err := utils.ClusterRollingUpdate(ctx, clientset, u.ClusterName, u.RollingUpdate.RollingUpdateOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckDrift computes whether the cluster needs to be applied and/or rolling updated, checks are skipped for the steps
+// the updater is configured to skip, a check that fails leaves the previous result unchanged because an unreachable
+// cloud or kubernetes api should not fail refreshing the state, only a missing cluster is reported as an error
+func (u *ClusterUpdater) CheckDrift(ctx context.Context, clientset simple.Clientset) error {
+	if !u.Apply.Skip {
+		changes, err := utils.ClusterChanges(ctx, clientset, u.ClusterName, u.Apply.ApplyOptions)
+		if errors.IsNotFound(err) {
 			return err
+		} else if err != nil {
+			log.Printf("unable to check if cluster %s needs to be applied: %v\n", u.ClusterName, err)
+		} else {
+			u.NeedsApply = len(changes) != 0
 		}
 	}
 	if !u.RollingUpdate.Skip {
-		if err := utils.ClusterRollingUpdate(clientset, u.ClusterName, u.RollingUpdate.RollingUpdateOptions); err != nil {
+		needsUpdate, err := utils.ClusterInstanceGroupsNeedingUpdate(ctx, clientset, u.ClusterName, u.RollingUpdate.InstanceGroups, u.RollingUpdate.InstanceGroupRoles)
+		if errors.IsNotFound(err) {
 			return err
+		} else if err != nil {
+			log.Printf("unable to check if cluster %s needs a rolling update: %v\n", u.ClusterName, err)
+		} else {
+			u.NeedsRollingUpdate = len(needsUpdate) != 0
 		}
 	}
 	return nil
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestCheckDrift(t *testing.T) {
+	cloud := awsup.InstallMockAWSCloud("us-mock-1", "abc")
+	cloud.MockEC2 = &mockec2.MockEC2{}
+	cloud.MockAutoscaling = &mockautoscaling.MockAutoscaling{}
+	ctx := context.Background()
+	clientset := vfsclientset.NewVFSClientset(vfs.NewMemFSPath(vfs.NewMemFSContext(), "state"))
+	if _, err := clientset.CreateCluster(ctx, testutils.BuildMinimalCluster("cluster.example.com")); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("failed check keeps previous value", func(t *testing.T) {
+		// the cluster kubernetes api is not reachable, instance groups needing update cannot be computed
+		u := ClusterUpdater{
+			ClusterName:        "cluster.example.com",
+			Apply:              ApplyOptions{Skip: true},
+			NeedsApply:         true,
+			NeedsRollingUpdate: true,
+		}
+		if err := u.CheckDrift(ctx, clientset); err != nil {
+			t.Fatal(err)
+		}
+		if !u.NeedsApply || !u.NeedsRollingUpdate {
+			t.Errorf("expected previous values to be kept, got %+v", u)
+		}
+	})
+	t.Run("missing cluster", func(t *testing.T) {
+		u := ClusterUpdater{
+			ClusterName: "missing.example.com",
+		}
+		if err := u.CheckDrift(ctx, clientset); !errors.IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestDeleteClusterWithRollingUpdateProgress(t *testing.T) {
+	// removed memfs files are still listed, a file system state store is used instead
+	stateStore := t.TempDir()
+	ctx := context.Background()
+	clientset := vfsclientset.NewVFSClientset(vfs.NewFSPath(stateStore))
+	cluster := testutils.BuildMinimalCluster("cluster.example.com")
+	cluster.Spec.ConfigBase = "file://" + filepath.Join(stateStore, cluster.Name)
+	kc, err := clientset.CreateCluster(ctx, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// record a rolling update the same way the cluster updater does
+	configBase, err := clientset.ConfigBaseFor(kc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := configBase.Join("rolling-update-progress.json").WriteFile(bytes.NewReader([]byte(`{"Status":"Completed"}`)), nil); err != nil {
+		t.Fatal(err)
+	}
+	if progress, err := utils.ClusterRollingUpdateProgress(ctx, clientset, kc.Name); err != nil || progress == nil {
+		t.Fatalf("expected rolling update progress to be recorded, got %v, %v", progress, err)
+	}
+	if err := DeleteCluster(ctx, kc.Name, utils.DeleteModeStateStoreOnly, clientset); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clientset.GetCluster(ctx, kc.Name); !errors.IsNotFound(err) {
+		t.Errorf("expected cluster to be deleted, got %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/client/simple"
@@ -17,55 +18,85 @@ type InstanceGroup struct {
 	ClusterName string
 	// Name defines the instance group name
 	Name string
+	// DeletionProtection prevents the instance group from being deleted, it is stored as an annotation in the state store
+	DeletionProtection bool
+	// DeleteMode defines what happens when the instance group is deleted (destroy, state_store_only or orphan), defaults to destroy
+	DeleteMode string
+	// Delete holds instance group deletion options
+	Delete utils.InstanceGroupDeleteOptions
 }
 
 func makeInstanceGroup(clusterName string, instanceGroup *kops.InstanceGroup) *InstanceGroup {
 	return &InstanceGroup{
-		ClusterName:       clusterName,
-		Name:              instanceGroup.ObjectMeta.Name,
-		InstanceGroupSpec: instanceGroup.Spec,
+		ClusterName:        clusterName,
+		Name:               instanceGroup.ObjectMeta.Name,
+		InstanceGroupSpec:  instanceGroup.Spec,
+		DeletionProtection: utils.HasDeletionProtection(instanceGroup.ObjectMeta),
 	}
 }
 
-func makeKopsInstanceGroup(name string, spec kops.InstanceGroupSpec) *kops.InstanceGroup {
-	return &kops.InstanceGroup{
+func makeKopsInstanceGroup(name string, annotations map[string]string, deletionProtection bool, spec kops.InstanceGroupSpec) *kops.InstanceGroup {
+	instanceGroup := &kops.InstanceGroup{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:        name,
+			Annotations: annotations,
 		},
 		Spec: spec,
 	}
+	utils.SetDeletionProtection(&instanceGroup.ObjectMeta, deletionProtection)
+	return instanceGroup
 }
 
-func GetInstanceGroup(clusterName, name string, clientset simple.Clientset) (*InstanceGroup, error) {
-	cluster, err := clientset.GetCluster(context.Background(), clusterName)
+func GetInstanceGroup(ctx context.Context, clusterName, name string, clientset simple.Clientset) (*InstanceGroup, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Get(context.Background(), name, metav1.GetOptions{})
+	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 	return makeInstanceGroup(clusterName, instanceGroup), nil
 }
 
-func CreateInstanceGroup(clusterName, name string, spec kops.InstanceGroupSpec, clientset simple.Clientset) (*InstanceGroup, error) {
-	cluster, err := clientset.GetCluster(context.Background(), clusterName)
+func ListInstanceGroups(ctx context.Context, clusterName string, clientset simple.Clientset) ([]InstanceGroup, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Create(context.Background(), makeKopsInstanceGroup(name, spec), metav1.CreateOptions{})
+	list, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var instanceGroups []InstanceGroup
+	for i := range list.Items {
+		instanceGroups = append(instanceGroups, *makeInstanceGroup(clusterName, &list.Items[i]))
+	}
+	return instanceGroups, nil
+}
+
+func CreateInstanceGroup(ctx context.Context, clusterName, name string, spec kops.InstanceGroupSpec, deletionProtection bool, clientset simple.Clientset) (*InstanceGroup, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Create(ctx, makeKopsInstanceGroup(name, nil, deletionProtection, spec), metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
 	}
 	return makeInstanceGroup(clusterName, instanceGroup), nil
 }
 
-func UpdateInstanceGroup(clusterName, name string, spec kops.InstanceGroupSpec, clientset simple.Clientset) (*InstanceGroup, error) {
-	cluster, err := clientset.GetCluster(context.Background(), clusterName)
+func UpdateInstanceGroup(ctx context.Context, clusterName, name string, spec kops.InstanceGroupSpec, deletionProtection bool, clientset simple.Clientset) (*InstanceGroup, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
-	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Update(context.Background(), makeKopsInstanceGroup(name, spec), metav1.UpdateOptions{})
+	instanceGroup, err = clientset.InstanceGroupsFor(cluster).Update(ctx, makeKopsInstanceGroup(name, instanceGroup.ObjectMeta.Annotations, deletionProtection, spec), metav1.UpdateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/pki"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// Keypair defines a keyset in the cluster CA store
+type Keypair struct {
+	// Revision is incremented every time the resource changes, this is useful for triggering cluster updater
+	Revision int
+	// ClusterName defines the cluster name the keyset belongs to
+	ClusterName string
+	// Name defines the keyset id (ca, etcd-clients-ca, service-account, apiserver-aggregator-ca, ...)
+	Name string
+	// Cert defines the PEM encoded certificate of the primary keypair
+	Cert string
+	// Key defines the PEM encoded private key of the primary keypair
+	Key string
+	// StagedCert defines a PEM encoded certificate trusted by the cluster before it is promoted to primary
+	StagedCert string
+	// Distrust contains the ids of keyset items to be removed from the keyset
+	Distrust []string
+	// PrimaryId is the id of the primary keypair
+	PrimaryId string
+	// Keys contains the items of the keyset
+	Keys []KeysetItem
+}
+
+// KeysetItem defines an item of a keyset
+type KeysetItem struct {
+	// Id is the unique identifier of the item in the keyset
+	Id string
+	// Cert is the PEM encoded certificate of the item
+	Cert string
+	// Primary indicates if the item is the primary keypair of the keyset
+	Primary bool
+}
+
+func keyStoreFor(ctx context.Context, clusterName string, clientset simple.Clientset) (fi.CAStore, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.KeyStore(cluster)
+}
+
+func GetKeypair(ctx context.Context, clusterName, name string, clientset simple.Clientset) (*Keypair, error) {
+	keyStore, err := keyStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return nil, err
+	}
+	keyset, err := keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return nil, err
+	}
+	if keyset == nil || len(keyset.Spec.Keys) == 0 {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "keyset"}, fmt.Sprintf("%s/%s", clusterName, name))
+	}
+	c, k, _, err := keyStore.FindKeypair(name)
+	if err != nil {
+		return nil, err
+	}
+	keypair := Keypair{
+		ClusterName: clusterName,
+		Name:        name,
+	}
+	if c != nil {
+		if keypair.Cert, err = c.AsString(); err != nil {
+			return nil, err
+		}
+	}
+	if k != nil {
+		if keypair.Key, err = k.AsString(); err != nil {
+			return nil, err
+		}
+	}
+	primary := fi.FindPrimary(keyset)
+	if primary != nil {
+		keypair.PrimaryId = primary.Id
+	}
+	for _, item := range keyset.Spec.Keys {
+		keypair.Keys = append(keypair.Keys, KeysetItem{
+			Id:      item.Id,
+			Cert:    string(item.PublicMaterial),
+			Primary: primary != nil && item.Id == primary.Id,
+		})
+	}
+	return &keypair, nil
+}
+
+func CreateKeypair(ctx context.Context, clusterName, name, cert, key, stagedCert string, distrust []string, clientset simple.Clientset) (*Keypair, error) {
+	return UpdateKeypair(ctx, clusterName, name, cert, key, stagedCert, distrust, clientset)
+}
+
+func UpdateKeypair(ctx context.Context, clusterName, name, cert, key, stagedCert string, distrust []string, clientset simple.Clientset) (*Keypair, error) {
+	keyStore, err := keyStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return nil, err
+	}
+	if err := storePrimaryKeypair(keyStore, name, cert, key); err != nil {
+		return nil, err
+	}
+	if err := stageKeypairCert(keyStore, name, stagedCert); err != nil {
+		return nil, err
+	}
+	if err := distrustKeypairs(keyStore, name, distrust); err != nil {
+		return nil, err
+	}
+	return GetKeypair(ctx, clusterName, name, clientset)
+}
+
+func DeleteKeypair(ctx context.Context, clusterName, name string, clientset simple.Clientset) error {
+	keyStore, err := keyStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return err
+	}
+	keyset, err := keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return err
+	}
+	if keyset == nil {
+		return nil
+	}
+	for _, item := range keyset.Spec.Keys {
+		if err := keyStore.DeleteKeysetItem(keyset, item.Id); err != nil {
+			return fmt.Errorf("error deleting keyset item %s/%s: %v", name, item.Id, err)
+		}
+	}
+	return nil
+}
+
+func storePrimaryKeypair(keyStore fi.CAStore, name, c, k string) error {
+	privateKey, err := pki.ParsePEMPrivateKey([]byte(k))
+	if err != nil {
+		return fmt.Errorf("error loading private key: %v", err)
+	}
+	cert, err := pki.ParsePEMCertificate([]byte(c))
+	if err != nil {
+		return fmt.Errorf("error loading certificate: %v", err)
+	}
+	id := cert.Certificate.SerialNumber.String()
+	keyset, err := keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return err
+	}
+	if keyset != nil {
+		primary := fi.FindPrimary(keyset)
+		if primary != nil && primary.Id == id {
+			return nil
+		}
+		// the primary keypair is the one with the highest id (certificate serial number), check before storing
+		// to avoid leaving a keypair that would never become primary in the keyset
+		if primary != nil {
+			if version, ok := big.NewInt(0).SetString(primary.Id, 10); ok && cert.Certificate.SerialNumber.Cmp(version) <= 0 {
+				return fmt.Errorf("keypair %s/%s cannot be primary, its certificate serial number must be greater than the current primary one (%s)", name, id, primary.Id)
+			}
+		}
+	}
+	if err := keyStore.StoreKeypair(name, cert, privateKey); err != nil {
+		return fmt.Errorf("error storing user provided keys: %v", err)
+	}
+	keyset, err = keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return err
+	}
+	// remove the staged copy of the certificate now that it has been promoted
+	for _, item := range keyset.Spec.Keys {
+		if item.Id != id && isSameCertificate(item, cert) {
+			if err := keyStore.DeleteKeysetItem(keyset, item.Id); err != nil {
+				return fmt.Errorf("error deleting staged certificate %s/%s: %v", name, item.Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func stageKeypairCert(keyStore fi.CAStore, name, c string) error {
+	if c == "" {
+		return nil
+	}
+	cert, err := pki.ParsePEMCertificate([]byte(c))
+	if err != nil {
+		return fmt.Errorf("error loading staged certificate: %v", err)
+	}
+	keyset, err := keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return err
+	}
+	if keyset != nil {
+		for _, item := range keyset.Spec.Keys {
+			if isSameCertificate(item, cert) {
+				return nil
+			}
+		}
+	}
+	if err := keyStore.AddCert(name, cert); err != nil {
+		return fmt.Errorf("error staging certificate: %v", err)
+	}
+	return nil
+}
+
+func isSameCertificate(item kops.KeysetItem, cert *pki.Certificate) bool {
+	if len(item.PublicMaterial) == 0 {
+		return false
+	}
+	c, err := pki.ParsePEMCertificate(item.PublicMaterial)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(c.Certificate.Raw, cert.Certificate.Raw)
+}
+
+func distrustKeypairs(keyStore fi.CAStore, name string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keyset, err := keyStore.FindCertificateKeyset(name)
+	if err != nil {
+		return err
+	}
+	if keyset == nil {
+		return nil
+	}
+	primary := fi.FindPrimary(keyset)
+	for _, id := range ids {
+		if primary != nil && primary.Id == id {
+			return fmt.Errorf("keypair %s/%s is primary and cannot be distrusted", name, id)
+		}
+		for _, item := range keyset.Spec.Keys {
+			if item.Id == id {
+				if err := keyStore.DeleteKeysetItem(keyset, id); err != nil {
+					return fmt.Errorf("error distrusting keypair %s/%s: %v", name, id, err)
+				}
+			}
+		}
+	}
+	return nil
+}
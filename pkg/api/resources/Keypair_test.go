@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func testKeypair(t *testing.T, serial int64) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(cert), string(privateKey)
+}
+
+func TestStorePrimaryKeypair(t *testing.T) {
+	cluster := &kops.Cluster{}
+	cluster.Name = "cluster.example.com"
+	keyStore := fi.NewVFSCAStore(cluster, vfs.NewMemFSPath(vfs.NewMemFSContext(), "pki"))
+
+	cert, key := testKeypair(t, 100)
+	if err := storePrimaryKeypair(keyStore, "ca", cert, key); err != nil {
+		t.Fatalf("unexpected error storing first keypair: %v", err)
+	}
+	// storing the primary keypair again is a no-op
+	if err := storePrimaryKeypair(keyStore, "ca", cert, key); err != nil {
+		t.Fatalf("unexpected error storing the same keypair: %v", err)
+	}
+
+	cert, key = testKeypair(t, 50)
+	if err := storePrimaryKeypair(keyStore, "ca", cert, key); err == nil {
+		t.Fatal("expected an error storing a keypair with a lower serial number")
+	}
+	keyset, err := keyStore.FindCertificateKeyset("ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keyset.Spec.Keys) != 1 || keyset.Spec.Keys[0].Id != "100" {
+		t.Fatalf("rejected keypair must not be stored, got %+v", keyset.Spec.Keys)
+	}
+
+	cert, key = testKeypair(t, 200)
+	if err := storePrimaryKeypair(keyStore, "ca", cert, key); err != nil {
+		t.Fatalf("unexpected error storing a newer keypair: %v", err)
+	}
+	keyset, err = keyStore.FindCertificateKeyset("ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primary := fi.FindPrimary(keyset); primary == nil || primary.Id != "200" {
+		t.Fatalf("expected keypair 200 to be primary, got %+v", primary)
+	}
+}
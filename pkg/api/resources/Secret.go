@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// Secret defines a secret in the cluster secret store
+type Secret struct {
+	// Revision is incremented every time the resource changes, this is useful for triggering cluster updater
+	Revision int
+	// ClusterName defines the cluster name the secret belongs to
+	ClusterName string
+	// Name defines the secret name (encryptionconfig, dockerconfig, ...)
+	Name string
+	// Data defines the secret content as a string
+	Data string
+	// DataBase64 defines the secret content base64 encoded, use it for binary payloads
+	DataBase64 string
+}
+
+func secretStoreFor(ctx context.Context, clusterName string, clientset simple.Clientset) (fi.SecretStore, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.SecretStore(cluster)
+}
+
+func GetSecret(ctx context.Context, clusterName, name string, clientset simple.Clientset) (*Secret, error) {
+	secretStore, err := secretStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secretStore.FindSecret(name)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "secret"}, fmt.Sprintf("%s/%s", clusterName, name))
+	}
+	out := Secret{
+		ClusterName: clusterName,
+		Name:        name,
+		DataBase64:  base64.StdEncoding.EncodeToString(secret.Data),
+	}
+	if utf8.Valid(secret.Data) {
+		out.Data = string(secret.Data)
+	}
+	return &out, nil
+}
+
+func CreateSecret(ctx context.Context, clusterName, name, data, dataBase64 string, clientset simple.Clientset) (*Secret, error) {
+	return UpdateSecret(ctx, clusterName, name, data, dataBase64, clientset)
+}
+
+func UpdateSecret(ctx context.Context, clusterName, name, data, dataBase64 string, clientset simple.Clientset) (*Secret, error) {
+	if data != "" && dataBase64 != "" {
+		return nil, fmt.Errorf("only one of data and data_base64 can be set for secret %s", name)
+	}
+	content := []byte(data)
+	if dataBase64 != "" {
+		decoded, err := base64.StdEncoding.DecodeString(dataBase64)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding base64 data of secret %s: %v", name, err)
+		}
+		content = decoded
+	}
+	secretStore, err := secretStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := secretStore.ReplaceSecret(name, &fi.Secret{Data: content}); err != nil {
+		return nil, fmt.Errorf("error setting secret %s: %v", name, err)
+	}
+	return GetSecret(ctx, clusterName, name, clientset)
+}
+
+func DeleteSecret(ctx context.Context, clusterName, name string, clientset simple.Clientset) error {
+	secretStore, err := secretStoreFor(ctx, clusterName, clientset)
+	if err != nil {
+		return err
+	}
+	secret, err := secretStore.FindSecret(name)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		return nil
+	}
+	return secretStore.DeleteSecret(name)
+}
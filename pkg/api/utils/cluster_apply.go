@@ -2,13 +2,52 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 )
 
-func ClusterApply(clientset simple.Clientset, clusterName string, allowKopsDowngrade bool) error {
-	kc, err := clientset.GetCluster(context.Background(), clusterName)
+type ApplyOptions struct {
+	// AllowKopsDowngrade permits applying with a kops version older than what was last used to apply to the cluster
+	AllowKopsDowngrade bool
+	// LifecycleOverrides overrides the lifecycle of tasks by name (IAMRole = "ExistsAndWarnIfChanges", SecurityGroup = "Ignore", ...)
+	LifecycleOverrides map[string]string
+	// Phase restricts the apply to a subset of tasks (assets, network, security or cluster), all phases are applied when empty
+	Phase string
+}
+
+func parsePhase(phase string) (cloudup.Phase, error) {
+	if phase != "" && !cloudup.Phases.Has(phase) {
+		return "", fmt.Errorf("unknown phase %q, available phases: %s", phase, strings.Join(cloudup.Phases.List(), ","))
+	}
+	return cloudup.Phase(phase), nil
+}
+
+func parseLifecycleOverrides(overrides map[string]string) (map[string]fi.Lifecycle, error) {
+	out := make(map[string]fi.Lifecycle)
+	for taskName, lifecycleName := range overrides {
+		lifecycle, ok := fi.LifecycleNameMap[lifecycleName]
+		if !ok {
+			return nil, fmt.Errorf("unknown lifecycle %q for task %s, available lifecycles: %s", lifecycleName, taskName, strings.Join(fi.Lifecycles.List(), ","))
+		}
+		out[taskName] = lifecycle
+	}
+	return out, nil
+}
+
+func ClusterApply(ctx context.Context, clientset simple.Clientset, clusterName string, options ApplyOptions) error {
+	phase, err := parsePhase(options.Phase)
+	if err != nil {
+		return err
+	}
+	lifecycleOverrides, err := parseLifecycleOverrides(options.LifecycleOverrides)
+	if err != nil {
+		return err
+	}
+	kc, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return err
 	}
@@ -21,7 +60,9 @@ func ClusterApply(clientset simple.Clientset, clusterName string, allowKopsDowng
 		Cluster:            kc,
 		Clientset:          clientset,
 		TargetName:         cloudup.TargetDirect,
-		AllowKopsDowngrade: allowKopsDowngrade,
+		AllowKopsDowngrade: options.AllowKopsDowngrade,
+		Phase:              phase,
+		LifecycleOverrides: lifecycleOverrides,
 	}
-	return apply.Run(context.Background())
+	return apply.Run(ctx)
 }
@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+)
+
+// ClusterChange defines a change that would be made to a cloud resource when applying a cluster
+type ClusterChange struct {
+	// Action is the kind of change (create, update or delete)
+	Action string
+	// Type is the resource type (LaunchTemplate, SecurityGroup, ...)
+	Type string
+	// Name is the resource name
+	Name string
+	// Fields contains the field level changes of the resource
+	Fields []ClusterChangeField
+}
+
+// ClusterChangeField defines a field level change of a cloud resource
+type ClusterChangeField struct {
+	// Name is the field name
+	Name string
+	// Old is the current value of the field
+	Old string
+	// New is the value the field would be set to
+	New string
+	// Diff contains the textual diff of the field when it holds a resource (user data, policy documents, ...)
+	Diff string
+}
+
+const (
+	clusterChangeCreate = "create"
+	clusterChangeUpdate = "update"
+	clusterChangeDelete = "delete"
+)
+
+func ClusterChanges(ctx context.Context, clientset simple.Clientset, clusterName string, options ApplyOptions) ([]ClusterChange, error) {
+	phase, err := parsePhase(options.Phase)
+	if err != nil {
+		return nil, err
+	}
+	lifecycleOverrides, err := parseLifecycleOverrides(options.LifecycleOverrides)
+	if err != nil {
+		return nil, err
+	}
+	kc, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	cloud, err := cloudup.BuildCloud(kc)
+	if err != nil {
+		return nil, err
+	}
+	apply := &cloudup.ApplyClusterCmd{
+		Cloud:              cloud,
+		Cluster:            kc,
+		Clientset:          clientset,
+		TargetName:         cloudup.TargetDryRun,
+		AllowKopsDowngrade: options.AllowKopsDowngrade,
+		Phase:              phase,
+		LifecycleOverrides: lifecycleOverrides,
+	}
+	if err := apply.Run(ctx); err != nil {
+		return nil, err
+	}
+	target, ok := apply.Target.(*fi.DryRunTarget)
+	if !ok {
+		return nil, fmt.Errorf("unexpected dry run target type %T", apply.Target)
+	}
+	var report bytes.Buffer
+	if err := target.PrintReport(apply.TaskMap, &report); err != nil {
+		return nil, err
+	}
+	changes := parseDryRunReport(report.String())
+	// the dry run target does not expose the changes it collected with their previous values, they are parsed from
+	// the report instead, fail rather than silently report no changes if the report format is not understood
+	if len(changes) == 0 && target.HasChanges() {
+		return nil, fmt.Errorf("unable to parse dry run report:\n%s", report.String())
+	}
+	return changes, nil
+}
+
+// parseDryRunReport converts the report printed by the kOps dry run target into structured changes,
+// the report format is the one of kOps 1.21
+func parseDryRunReport(report string) []ClusterChange {
+	var changes []ClusterChange
+	var action string
+	var field *ClusterChangeField
+	scanner := bufio.NewScanner(strings.NewReader(report))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			continue
+		case line == "Will create resources:":
+			action, field = clusterChangeCreate, nil
+		case line == "Will modify resources:":
+			action, field = clusterChangeUpdate, nil
+		case line == "Will delete items:":
+			action, field = clusterChangeDelete, nil
+		case action == clusterChangeDelete:
+			parts := strings.Fields(line)
+			if len(parts) == 0 {
+				continue
+			}
+			changes = append(changes, ClusterChange{
+				Action: action,
+				Type:   parts[0],
+				Name:   strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), parts[0])),
+			})
+		case strings.HasPrefix(line, "  \t"):
+			if len(changes) == 0 {
+				continue
+			}
+			change := &changes[len(changes)-1]
+			parts := strings.SplitN(strings.TrimPrefix(line, "  \t"), "\t", 2)
+			name := strings.TrimSpace(parts[0])
+			value := ""
+			if len(parts) == 2 {
+				value = parts[1]
+			}
+			if name == "" {
+				// continuation of a multi line diff
+				if field != nil {
+					field.Diff += value + "\n"
+				}
+				continue
+			}
+			change.Fields = append(change.Fields, ClusterChangeField{Name: name})
+			field = &change.Fields[len(change.Fields)-1]
+			if action == clusterChangeCreate {
+				field.New = value
+			} else if values := strings.SplitN(strings.TrimPrefix(value, " "), " -> ", 2); len(values) == 2 {
+				field.Old, field.New = values[0], values[1]
+			}
+		case strings.HasPrefix(line, "   "):
+			// kOps internal consistency error details
+			continue
+		case strings.HasPrefix(line, "  "):
+			parts := strings.SplitN(strings.TrimPrefix(line, "  "), "/", 2)
+			change := ClusterChange{
+				Action: action,
+				Type:   parts[0],
+			}
+			if len(parts) == 2 {
+				change.Name = parts[1]
+			}
+			changes = append(changes, change)
+			field = nil
+		}
+	}
+	return changes
+}
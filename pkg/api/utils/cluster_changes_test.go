@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/pkg/diff"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+)
+
+type testDeletion struct {
+	taskName string
+	item     string
+}
+
+func (d *testDeletion) Delete(fi.Target) error { return nil }
+func (d *testDeletion) TaskName() string       { return d.taskName }
+func (d *testDeletion) Item() string           { return d.item }
+
+// dryRunReport renders tasks with the kOps dry run target and returns the report it prints
+func dryRunReport(t *testing.T, f func(*fi.DryRunTarget, map[string]fi.Task)) string {
+	target := fi.NewDryRunTarget(&assets.AssetBuilder{}, nil)
+	taskMap := map[string]fi.Task{}
+	f(target, taskMap)
+	var report bytes.Buffer
+	if err := target.PrintReport(taskMap, &report); err != nil {
+		t.Fatal(err)
+	}
+	return report.String()
+}
+
+func TestParseDryRunReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		render func(*fi.DryRunTarget, map[string]fi.Task)
+		want   []ClusterChange
+	}{
+		{
+			name:   "empty",
+			render: func(*fi.DryRunTarget, map[string]fi.Task) {},
+			want:   nil,
+		},
+		{
+			name: "created",
+			render: func(target *fi.DryRunTarget, taskMap map[string]fi.Task) {
+				e := &awstasks.SecurityGroup{
+					Name:             fi.String("nodes.cluster.example.com"),
+					Description:      fi.String("Security group for nodes"),
+					RemoveExtraRules: []string{"port=22"},
+				}
+				taskMap["SecurityGroup/nodes.cluster.example.com"] = e
+				target.Render((*awstasks.SecurityGroup)(nil), e, e)
+			},
+			want: []ClusterChange{{
+				Action: clusterChangeCreate,
+				Type:   "SecurityGroup",
+				Name:   "nodes.cluster.example.com",
+				Fields: []ClusterChangeField{
+					{Name: "Description", New: "Security group for nodes"},
+					{Name: "RemoveExtraRules", New: "[port=22]"},
+				},
+			}},
+		},
+		{
+			name: "changed",
+			render: func(target *fi.DryRunTarget, taskMap map[string]fi.Task) {
+				a := &awstasks.SecurityGroup{
+					Name:        fi.String("nodes.cluster.example.com"),
+					Description: fi.String("old"),
+				}
+				e := &awstasks.SecurityGroup{
+					Name:        fi.String("nodes.cluster.example.com"),
+					Description: fi.String("new"),
+				}
+				taskMap["SecurityGroup/nodes.cluster.example.com"] = e
+				target.Render(a, e, &awstasks.SecurityGroup{Description: fi.String("new")})
+			},
+			want: []ClusterChange{{
+				Action: clusterChangeUpdate,
+				Type:   "SecurityGroup",
+				Name:   "nodes.cluster.example.com",
+				Fields: []ClusterChangeField{
+					{Name: "Description", Old: "old", New: "new"},
+				},
+			}},
+		},
+		{
+			name: "changed resource",
+			render: func(target *fi.DryRunTarget, taskMap map[string]fi.Task) {
+				a := &awstasks.LaunchTemplate{
+					Name:     fi.String("nodes.cluster.example.com"),
+					UserData: fi.NewStringResource("line1\nline2\n"),
+				}
+				e := &awstasks.LaunchTemplate{
+					Name:     fi.String("nodes.cluster.example.com"),
+					UserData: fi.NewStringResource("line1\nline3\n"),
+				}
+				taskMap["LaunchTemplate/nodes.cluster.example.com"] = e
+				target.Render(a, e, &awstasks.LaunchTemplate{UserData: e.UserData})
+			},
+			want: []ClusterChange{{
+				Action: clusterChangeUpdate,
+				Type:   "LaunchTemplate",
+				Name:   "nodes.cluster.example.com",
+				Fields: []ClusterChangeField{
+					{Name: "UserData", Diff: diff.FormatDiff("line1\nline2\n", "line1\nline3\n") + "\n"},
+				},
+			}},
+		},
+		{
+			name: "deleted",
+			render: func(target *fi.DryRunTarget, taskMap map[string]fi.Task) {
+				target.Delete(&testDeletion{taskName: "LaunchTemplate", item: "lt-0123456789"})
+				target.Delete(&testDeletion{taskName: "AutoscalingGroup", item: "nodes.cluster.example.com"})
+			},
+			want: []ClusterChange{
+				{Action: clusterChangeDelete, Type: "AutoscalingGroup", Name: "nodes.cluster.example.com"},
+				{Action: clusterChangeDelete, Type: "LaunchTemplate", Name: "lt-0123456789"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := dryRunReport(t, tt.render)
+			if got := parseDryRunReport(report); !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected changes for report:\n%s\n%s", report, cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestClusterChangesApplyOptions(t *testing.T) {
+	// apply options are validated before the cluster is loaded, a nil clientset is never used
+	tests := []struct {
+		name    string
+		options ApplyOptions
+	}{
+		{
+			name:    "unknown phase",
+			options: ApplyOptions{Phase: "unknown"},
+		},
+		{
+			name:    "kops cli phase alias",
+			options: ApplyOptions{Phase: "iam"},
+		},
+		{
+			name:    "unknown lifecycle",
+			options: ApplyOptions{LifecycleOverrides: map[string]string{"SecurityGroup": "Unknown"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ClusterChanges(context.Background(), nil, "cluster.example.com", tt.options); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
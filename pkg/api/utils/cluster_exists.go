@@ -7,8 +7,8 @@ import (
 	"k8s.io/kops/pkg/client/simple"
 )
 
-func ClusterExists(clientset simple.Clientset, clusterName string) (bool, error) {
-	_, err := clientset.GetCluster(context.Background(), clusterName)
+func ClusterExists(ctx context.Context, clientset simple.Clientset, clusterName string) (bool, error) {
+	_, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil
@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+)
+
+const (
+	// UpdateReasonCloudConfiguration is reported when the instance was not created from the current cloud group configuration
+	UpdateReasonCloudConfiguration = "CloudConfiguration"
+	// UpdateReasonNodeAnnotation is reported when the node backing the instance is annotated with kops.k8s.io/needs-update
+	UpdateReasonNodeAnnotation = "NodeAnnotation"
+)
+
+// Instance represents a cloud instance belonging to a cluster
+type Instance struct {
+	// Id is the cloud id of the instance
+	Id string
+	// InstanceGroup is the name of the instance group the instance belongs to
+	InstanceGroup string
+	// Role is the role of the instance group the instance belongs to
+	Role string
+	// MachineType is the machine type of the instance
+	MachineType string
+	// PrivateIp is the private ip address of the instance
+	PrivateIp string
+	// NodeName is the name of the kubernetes node backing the instance, if it joined the cluster
+	NodeName string
+	// Status is the cloud status of the instance (UpToDate, NeedsUpdate or Detached)
+	Status string
+	// NeedsUpdate indicates if the instance needs to be replaced
+	NeedsUpdate bool
+	// UpdateReason explains why the instance needs to be replaced (CloudConfiguration or NodeAnnotation)
+	UpdateReason string
+}
+
+// ClusterInstances lists the cloud instances of a cluster, the same way kops get instances does
+func ClusterInstances(ctx context.Context, clientset simple.Clientset, clusterName string) ([]Instance, error) {
+	kc, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	list, err := clientset.InstanceGroupsFor(kc).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var instanceGroups []*kops.InstanceGroup
+	for i := range list.Items {
+		instanceGroups = append(instanceGroups, &list.Items[i])
+	}
+	cloud, err := cloudup.BuildCloud(kc)
+	if err != nil {
+		return nil, err
+	}
+	// instances are still reported without their node name when the kubernetes api is not reachable, as kops get instances does
+	nodes, err := clusterNodes(ctx, clientset, clusterName)
+	if err != nil {
+		log.Printf("cannot list node names, kubernetes api unavailable: %v\n", err)
+	}
+	groups, err := cloud.GetCloudGroups(kc, instanceGroups, false, nodes)
+	if err != nil {
+		return nil, err
+	}
+	var instances []Instance
+	for _, group := range groups {
+		// instances needing an update before adjusting were flagged by the cloud provider,
+		// the other ones are flagged because of their node annotation
+		reasons := map[*cloudinstances.CloudInstance]string{}
+		for _, instance := range group.NeedUpdate {
+			reasons[instance] = UpdateReasonCloudConfiguration
+		}
+		group.AdjustNeedUpdate()
+		for _, instance := range group.NeedUpdate {
+			if _, ok := reasons[instance]; !ok {
+				reasons[instance] = UpdateReasonNodeAnnotation
+			}
+		}
+		for _, members := range [][]*cloudinstances.CloudInstance{group.Ready, group.NeedUpdate} {
+			for _, member := range members {
+				instance := Instance{
+					Id:           member.ID,
+					MachineType:  member.MachineType,
+					PrivateIp:    member.PrivateIP,
+					Status:       member.Status,
+					UpdateReason: reasons[member],
+					NeedsUpdate:  reasons[member] != "",
+				}
+				if group.InstanceGroup != nil {
+					instance.InstanceGroup = group.InstanceGroup.Name
+					instance.Role = string(group.InstanceGroup.Spec.Role)
+				}
+				if member.Node != nil {
+					instance.NodeName = member.Node.Name
+				}
+				instances = append(instances, instance)
+			}
+		}
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		if instances[i].InstanceGroup != instances[j].InstanceGroup {
+			return instances[i].InstanceGroup < instances[j].InstanceGroup
+		}
+		return instances[i].Id < instances[j].Id
+	})
+	return instances, nil
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
 	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/validation"
@@ -37,16 +39,58 @@ type RollingUpdateOptions struct {
 	CloudOnly bool
 	// Force forces a rolling update
 	Force bool
+	// InstanceGroups restricts the rolling update to the instance groups with the given names
+	InstanceGroups []string
+	// InstanceGroupRoles restricts the rolling update to the instance groups with the given roles (Master, Node, Bastion, ...)
+	InstanceGroupRoles []string
 }
 
-func ClusterInstanceGroupsNeedingUpdate(clientset simple.Clientset, clusterName string) ([]string, error) {
-	kc, err := clientset.GetCluster(context.Background(), clusterName)
+// filterInstanceGroups keeps the instance groups matching names and roles, the same way kops rolling-update cluster
+// handles --instance-group and --instance-group-roles flags
+func filterInstanceGroups(instanceGroups []*kops.InstanceGroup, names []string, roles []string) ([]*kops.InstanceGroup, error) {
+	if len(names) != 0 {
+		var filtered []*kops.InstanceGroup
+		for _, name := range names {
+			var found *kops.InstanceGroup
+			for _, ig := range instanceGroups {
+				if ig.ObjectMeta.Name == name {
+					found = ig
+					break
+				}
+			}
+			if found == nil {
+				return nil, fmt.Errorf("InstanceGroup %q not found", name)
+			}
+			filtered = append(filtered, found)
+		}
+		instanceGroups = filtered
+	}
+	if len(roles) != 0 {
+		var filtered []*kops.InstanceGroup
+		for _, role := range roles {
+			r, ok := kops.ParseInstanceGroupRole(role, true)
+			if !ok {
+				return nil, fmt.Errorf("invalid instance group role %q", role)
+			}
+			for _, ig := range instanceGroups {
+				if ig.Spec.Role == r {
+					filtered = append(filtered, ig)
+				}
+			}
+		}
+		instanceGroups = filtered
+	}
+	return instanceGroups, nil
+}
+
+func ClusterInstanceGroupsNeedingUpdate(ctx context.Context, clientset simple.Clientset, clusterName string, names []string, roles []string) ([]string, error) {
+	kc, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
 	var k8sClient kubernetes.Interface
 	var nodes []v1.Node
-	configBuilder, err := GetKubeConfigBuilder(clientset, clusterName, nil, false)
+	configBuilder, err := GetKubeConfigBuilder(ctx, clientset, clusterName, nil, false)
 	if err != nil {
 		return nil, err
 	}
@@ -58,14 +102,14 @@ func ClusterInstanceGroupsNeedingUpdate(clientset simple.Clientset, clusterName
 	if err != nil {
 		return nil, fmt.Errorf("cannot build kube client for %q: %v", kc.Name, err)
 	}
-	nodeList, err := k8sClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 	if nodeList != nil {
 		nodes = nodeList.Items
 	}
-	list, err := clientset.InstanceGroupsFor(kc).List(context.Background(), metav1.ListOptions{})
+	list, err := clientset.InstanceGroupsFor(kc).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +117,10 @@ func ClusterInstanceGroupsNeedingUpdate(clientset simple.Clientset, clusterName
 	for i := range list.Items {
 		instanceGroups = append(instanceGroups, &list.Items[i])
 	}
+	instanceGroups, err = filterInstanceGroups(instanceGroups, names, roles)
+	if err != nil {
+		return nil, err
+	}
 	cloud, err := cloudup.BuildCloud(kc)
 	if err != nil {
 		return nil, err
@@ -104,14 +152,14 @@ func ClusterInstanceGroupsNeedingUpdate(clientset simple.Clientset, clusterName
 	return needUpdate, nil
 }
 
-func ClusterRollingUpdate(clientset simple.Clientset, clusterName string, options RollingUpdateOptions) error {
-	kc, err := clientset.GetCluster(context.Background(), clusterName)
+func ClusterRollingUpdate(ctx context.Context, clientset simple.Clientset, clusterName string, options RollingUpdateOptions) error {
+	kc, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return err
 	}
 	var k8sClient kubernetes.Interface
 	var nodes []v1.Node
-	configBuilder, err := GetKubeConfigBuilder(clientset, clusterName, nil, false)
+	configBuilder, err := GetKubeConfigBuilder(ctx, clientset, clusterName, nil, false)
 	if err != nil {
 		return err
 	}
@@ -123,14 +171,14 @@ func ClusterRollingUpdate(clientset simple.Clientset, clusterName string, option
 	if err != nil {
 		return fmt.Errorf("cannot build kube client for %q: %v", kc.Name, err)
 	}
-	nodeList, err := k8sClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
 	if nodeList != nil {
 		nodes = nodeList.Items
 	}
-	list, err := clientset.InstanceGroupsFor(kc).List(context.Background(), metav1.ListOptions{})
+	list, err := clientset.InstanceGroupsFor(kc).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
@@ -138,6 +186,10 @@ func ClusterRollingUpdate(clientset simple.Clientset, clusterName string, option
 	for i := range list.Items {
 		instanceGroups = append(instanceGroups, &list.Items[i])
 	}
+	instanceGroups, err = filterInstanceGroups(instanceGroups, options.InstanceGroups, options.InstanceGroupRoles)
+	if err != nil {
+		return err
+	}
 	cloud, err := cloudup.BuildCloud(kc)
 	if err != nil {
 		return err
@@ -171,7 +223,7 @@ func ClusterRollingUpdate(clientset simple.Clientset, clusterName string, option
 		ValidateCount = *options.ValidateCount
 	}
 	d := &instancegroups.RollingUpdateCluster{
-		Ctx:                     context.TODO(),
+		Ctx:                     ctx,
 		Cluster:                 kc,
 		Clientset:               clientset,
 		MasterInterval:          MasterInterval,
@@ -195,23 +247,104 @@ func ClusterRollingUpdate(clientset simple.Clientset, clusterName string, option
 	if err != nil {
 		return err
 	}
-	var l []*cloudinstances.CloudInstanceGroup
-	for _, v := range groups {
-		l = append(l, v)
-	}
 	needUpdate := false
 	for _, group := range groups {
 		if len(group.NeedUpdate) != 0 {
 			needUpdate = true
 		}
 	}
+	progress, err := newRollingUpdateProgress(clientset, kc, options.InstanceGroups, options.InstanceGroupRoles)
+	if err != nil {
+		return err
+	}
+	if progress.Resumes != 0 {
+		log.Printf("resuming rolling update of cluster %s started at %s, completed instance groups: %v, replaced instances: %v\n", kc.Name, progress.StartedAt, progress.CompletedInstanceGroups, progress.ReplacedInstances)
+	}
 	if !needUpdate && !options.Force {
+		// a resumed rolling update has nothing left to do
+		if progress.Resumes != 0 {
+			if progress.CurrentInstanceGroup != "" {
+				progress.startInstanceGroup(progress.CurrentInstanceGroup, nil)
+				progress.completeInstanceGroup()
+			}
+			progress.complete()
+			return writeRollingUpdateProgress(clientset, kc, progress)
+		}
 		return nil
 	}
-	clusterValidator, err := validation.NewClusterValidator(kc, cloud, list, config, k8sClient)
+	clusterValidator, err := validation.NewClusterValidator(ctx, kc, cloud, list, config, k8sClient)
 	if err != nil {
 		return fmt.Errorf("cannot create cluster validator: %v", err)
 	}
 	d.ClusterValidator = clusterValidator
-	return d.RollingUpdate(groups, list)
+	// instance groups are rolled one at a time so that progress can be recorded after each of them,
+	// in the same order kops uses: bastions, masters, api servers and nodes
+	var failed error
+	for _, group := range sortCloudGroups(groups) {
+		name := group.InstanceGroup.Name
+		// an instance group rolled by the interrupted run is rolled again if it needs an update since then,
+		// because the cluster spec changed or instances were replaced outside of the rolling update
+		if progress.isCompleted(name) && len(group.NeedUpdate) == 0 {
+			continue
+		}
+		var pending []string
+		for _, instance := range group.NeedUpdate {
+			pending = append(pending, instance.ID)
+		}
+		if options.Force {
+			for _, instance := range group.Ready {
+				pending = append(pending, instance.ID)
+			}
+		}
+		progress.startInstanceGroup(name, pending)
+		if err := writeRollingUpdateProgress(clientset, kc, progress); err != nil {
+			return err
+		}
+		if err := d.RollingUpdate(map[string]*cloudinstances.CloudInstanceGroup{name: group}, list); err != nil {
+			if failed == nil {
+				failed = err
+			}
+			// like kops, the rolling update stops when a bastion or master group fails, the cluster is potentially
+			// unhealthy, other api server and node groups are still rolled when one of them fails
+			if role := group.InstanceGroup.Spec.Role; role == kops.InstanceGroupRoleBastion || role == kops.InstanceGroupRoleMaster {
+				break
+			}
+			continue
+		}
+		progress.completeInstanceGroup()
+		if err := writeRollingUpdateProgress(clientset, kc, progress); err != nil {
+			return err
+		}
+	}
+	if failed != nil {
+		progress.fail(failed)
+		if err := writeRollingUpdateProgress(clientset, kc, progress); err != nil {
+			log.Printf("failed to record rolling update progress of cluster %s: %v\n", kc.Name, err)
+		}
+		return failed
+	}
+	progress.complete()
+	return writeRollingUpdateProgress(clientset, kc, progress)
+}
+
+// sortCloudGroups orders cloud groups by role and name
+func sortCloudGroups(groups map[string]*cloudinstances.CloudInstanceGroup) []*cloudinstances.CloudInstanceGroup {
+	order := map[kops.InstanceGroupRole]int{
+		kops.InstanceGroupRoleBastion:   0,
+		kops.InstanceGroupRoleMaster:    1,
+		kops.InstanceGroupRoleAPIServer: 2,
+		kops.InstanceGroupRoleNode:      3,
+	}
+	var sorted []*cloudinstances.CloudInstanceGroup
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		ri, rj := order[sorted[i].InstanceGroup.Spec.Role], order[sorted[j].InstanceGroup.Spec.Role]
+		if ri != rj {
+			return ri < rj
+		}
+		return sorted[i].InstanceGroup.Name < sorted[j].InstanceGroup.Name
+	})
+	return sorted
 }
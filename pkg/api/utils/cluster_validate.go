@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/validation"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/kops/pkg/apis/kops"
@@ -22,8 +23,8 @@ type ValidateOptions struct {
 	PollInterval *metav1.Duration
 }
 
-func makeValidator(clientset simple.Clientset, clusterName string) (kopsValidation.ClusterValidator, error) {
-	kc, err := clientset.GetCluster(context.Background(), clusterName)
+func makeValidator(ctx context.Context, clientset simple.Clientset, clusterName string) (kopsValidation.ClusterValidator, error) {
+	kc, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +32,7 @@ func makeValidator(clientset simple.Clientset, clusterName string) (kopsValidati
 	if err != nil {
 		return nil, err
 	}
-	list, err := clientset.InstanceGroupsFor(kc).List(context.Background(), metav1.ListOptions{})
+	list, err := clientset.InstanceGroupsFor(kc).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("cannot get InstanceGroups for %q: %v", kc.ObjectMeta.Name, err)
 	}
@@ -42,7 +43,7 @@ func makeValidator(clientset simple.Clientset, clusterName string) (kopsValidati
 	if len(instanceGroups) == 0 {
 		return nil, fmt.Errorf("no InstanceGroup objects found")
 	}
-	configBuilder, err := GetKubeConfigBuilder(clientset, clusterName, nil, false)
+	configBuilder, err := GetKubeConfigBuilder(ctx, clientset, clusterName, nil, false)
 	if err != nil {
 		return nil, err
 	}
@@ -54,30 +55,95 @@ func makeValidator(clientset simple.Clientset, clusterName string) (kopsValidati
 	if err != nil {
 		return nil, fmt.Errorf("cannot build kubernetes api client for %q: %v", kc.Name, err)
 	}
-	validator, err := validation.NewClusterValidator(kc, cloud, list, config, k8sClient)
+	validator, err := validation.NewClusterValidator(ctx, kc, cloud, list, config, k8sClient)
 	if err != nil {
 		return nil, fmt.Errorf("unexpected error creating validatior: %v", err)
 	}
 	return validator, nil
 }
 
-func ClusterIsValid(clientset simple.Clientset, clusterName string) (bool, error) {
-	if validator, err := makeValidator(clientset, clusterName); err != nil {
-		return false, err
-	} else {
-		result, err := validator.Validate()
-		if err != nil {
-			return false, err
-		}
-		if len(result.Failures) != 0 {
-			return false, fmt.Errorf("Validation failures")
-		}
-		return true, nil
+// ValidationFailure defines a cluster validation failure
+type ValidationFailure struct {
+	// Kind is the kind of object that failed validation (Node, Pod, ComponentStatus, ...)
+	Kind string
+	// Name is the name of the object that failed validation
+	Name string
+	// Message describes the validation failure
+	Message string
+}
+
+// ValidationNode defines a node checked during cluster validation
+type ValidationNode struct {
+	// Name is the node name
+	Name string
+	// Role is the node role (master or node)
+	Role string
+	// Zone is the zone the node is running in
+	Zone string
+	// Hostname is the node hostname
+	Hostname string
+	// Ready indicates if the node is ready
+	Ready bool
+}
+
+// ClusterValidationResult holds the result of a cluster validation
+type ClusterValidationResult struct {
+	// IsValid indicates if the cluster passed validation
+	IsValid bool
+	// Failures contains the validation failures
+	Failures []ValidationFailure
+	// Nodes contains the nodes checked during validation
+	Nodes []ValidationNode
+}
+
+// ClusterFailure returns a validation failure reporting an error that prevented checking the cluster
+func ClusterFailure(clusterName, message string, err error) ValidationFailure {
+	return ValidationFailure{
+		Kind:    "Cluster",
+		Name:    clusterName,
+		Message: fmt.Sprintf("%s: %v", message, err),
+	}
+}
+
+// ClusterValidation validates the cluster once, failing validation is not considered an error, neither is
+// an unreachable cluster, both are reported as validation failures
+func ClusterValidation(ctx context.Context, clientset simple.Clientset, clusterName string) (*ClusterValidationResult, error) {
+	validator, err := makeValidator(ctx, clientset, clusterName)
+	if err != nil {
+		return &ClusterValidationResult{
+			Failures: []ValidationFailure{ClusterFailure(clusterName, "unable to validate cluster", err)},
+		}, nil
+	}
+	result, err := validator.Validate()
+	if err != nil {
+		return &ClusterValidationResult{
+			Failures: []ValidationFailure{ClusterFailure(clusterName, "unexpected error during validation", err)},
+		}, nil
+	}
+	out := ClusterValidationResult{
+		IsValid: len(result.Failures) == 0,
+	}
+	for _, failure := range result.Failures {
+		out.Failures = append(out.Failures, ValidationFailure{
+			Kind:    failure.Kind,
+			Name:    failure.Name,
+			Message: failure.Message,
+		})
 	}
+	for _, node := range result.Nodes {
+		out.Nodes = append(out.Nodes, ValidationNode{
+			Name:     node.Name,
+			Role:     node.Role,
+			Zone:     node.Zone,
+			Hostname: node.Hostname,
+			Ready:    node.Status == v1.ConditionTrue,
+		})
+	}
+	return &out, nil
 }
 
-func ClusterValidate(clientset simple.Clientset, clusterName string, options ValidateOptions) error {
-	if validator, err := makeValidator(clientset, clusterName); err != nil {
+func ClusterValidate(ctx context.Context, clientset simple.Clientset, clusterName string, options ValidateOptions) error {
+	if validator, err := makeValidator(ctx, clientset, clusterName); err != nil {
 		return err
 	} else {
 		timeout := time.Now()
@@ -99,14 +165,18 @@ func ClusterValidate(clientset simple.Clientset, clusterName string, options Val
 			if err != nil {
 				consecutive = 0
 				log.Printf("(will retry): unexpected error during validation: %v\n", err)
-				time.Sleep(pollInterval)
+				if err := sleepWithContext(ctx, pollInterval); err != nil {
+					return err
+				}
 				continue
 			}
 			if len(result.Failures) == 0 {
 				consecutive++
 				if consecutive < 0 {
 					log.Printf("(will retry): cluster passed validation %d consecutive times\n", consecutive)
-					time.Sleep(pollInterval)
+					if err := sleepWithContext(ctx, pollInterval); err != nil {
+						return err
+					}
 					continue
 				} else {
 					return nil
@@ -114,10 +184,22 @@ func ClusterValidate(clientset simple.Clientset, clusterName string, options Val
 			} else {
 				if consecutive == 0 {
 					log.Println("(will retry): cluster not yet healthy")
-					time.Sleep(pollInterval)
+					if err := sleepWithContext(ctx, pollInterval); err != nil {
+						return err
+					}
 					continue
 				}
 			}
 		}
 	}
 }
+
+// sleepWithContext waits for the given duration, it returns early with an error if the context is done
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeletionProtectionAnnotation is the annotation protecting clusters and instance groups from deletion
+const DeletionProtectionAnnotation = "kops.eddycharly.io/deletion-protection"
+
+const (
+	// DeleteModeDestroy deletes cloud resources and removes the object from the state store
+	DeleteModeDestroy = "destroy"
+	// DeleteModeStateStoreOnly removes the object from the state store but leaves cloud resources untouched
+	DeleteModeStateStoreOnly = "state_store_only"
+	// DeleteModeOrphan leaves both the state store and cloud resources untouched
+	DeleteModeOrphan = "orphan"
+)
+
+func HasDeletionProtection(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[DeletionProtectionAnnotation] == "true"
+}
+
+func SetDeletionProtection(meta *metav1.ObjectMeta, enabled bool) {
+	if enabled {
+		if meta.Annotations == nil {
+			meta.Annotations = map[string]string{}
+		}
+		meta.Annotations[DeletionProtectionAnnotation] = "true"
+	} else {
+		delete(meta.Annotations, DeletionProtectionAnnotation)
+	}
+}
+
+// ParseDeleteMode validates a delete mode, an empty delete mode defaults to destroy
+func ParseDeleteMode(deleteMode string) (string, error) {
+	switch deleteMode {
+	case "":
+		return DeleteModeDestroy, nil
+	case DeleteModeDestroy, DeleteModeStateStoreOnly, DeleteModeOrphan:
+		return deleteMode, nil
+	default:
+		return "", fmt.Errorf("unknown delete mode %q, available modes: %s, %s, %s", deleteMode, DeleteModeDestroy, DeleteModeStateStoreOnly, DeleteModeOrphan)
+	}
+}
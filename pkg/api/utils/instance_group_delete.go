@@ -2,26 +2,63 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
 
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/client/simple"
 	"k8s.io/kops/pkg/instancegroups"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/drain"
 )
 
-func InstanceGroupDelete(clientset simple.Clientset, clusterName string, instanceGroupName string) error {
-	cluster, err := clientset.GetCluster(context.Background(), clusterName)
+type InstanceGroupDeleteOptions struct {
+	// SkipDrain allows deleting the instance group without cordoning and draining its nodes first, useful when the cluster API server is unreachable
+	SkipDrain bool
+	// FailOnDrainError will fail when a drain error occurs
+	FailOnDrainError bool
+	// PostDrainDelay is the duration we wait after draining each node
+	PostDrainDelay *metav1.Duration
+}
+
+func InstanceGroupDelete(ctx context.Context, clientset simple.Clientset, clusterName string, instanceGroupName string, deleteMode string, options InstanceGroupDeleteOptions) error {
+	deleteMode, err := ParseDeleteMode(deleteMode)
 	if err != nil {
 		return err
 	}
-	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Get(context.Background(), instanceGroupName, metav1.GetOptions{})
+	if deleteMode == DeleteModeOrphan {
+		return nil
+	}
+	cluster, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return err
 	}
+	instanceGroup, err := clientset.InstanceGroupsFor(cluster).Get(ctx, instanceGroupName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if HasDeletionProtection(instanceGroup.ObjectMeta) {
+		return fmt.Errorf("instance group %s/%s has deletion protection enabled, set deletion_protection to false and apply before deleting it", clusterName, instanceGroupName)
+	}
+	if deleteMode == DeleteModeStateStoreOnly {
+		return clientset.InstanceGroupsFor(cluster).Delete(ctx, instanceGroupName, metav1.DeleteOptions{})
+	}
 	cloud, err := cloudup.BuildCloud(cluster)
 	if err != nil {
 		return err
 	}
+	if !options.SkipDrain {
+		if err := drainInstanceGroup(ctx, clientset, cluster, cloud, instanceGroup, options); err != nil {
+			return err
+		}
+	}
 	d := &instancegroups.DeleteInstanceGroup{
 		Cluster:   cluster,
 		Cloud:     cloud,
@@ -33,3 +70,75 @@ func InstanceGroupDelete(clientset simple.Clientset, clusterName string, instanc
 	}
 	return nil
 }
+
+// drainInstanceGroup cordons and drains the nodes of an instance group, pods are evicted to honour PodDisruptionBudgets
+func drainInstanceGroup(ctx context.Context, clientset simple.Clientset, cluster *kops.Cluster, cloud fi.Cloud, instanceGroup *kops.InstanceGroup, options InstanceGroupDeleteOptions) error {
+	configBuilder, err := GetKubeConfigBuilder(ctx, clientset, cluster.Name, nil, false)
+	if err != nil {
+		return err
+	}
+	config, err := configBuilder.BuildRestConfig()
+	if err != nil {
+		return err
+	}
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("cannot build kube client for %q: %v", cluster.Name, err)
+	}
+	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot list nodes of cluster %q, set skip_drain if the API server is unreachable: %v", cluster.Name, err)
+	}
+	groups, err := cloud.GetCloudGroups(cluster, []*kops.InstanceGroup{instanceGroup}, false, nodeList.Items)
+	if err != nil {
+		return err
+	}
+	postDrainDelay := 5 * time.Second
+	if options.PostDrainDelay != nil {
+		postDrainDelay = options.PostDrainDelay.Duration
+	}
+	helper := &drain.Helper{
+		Ctx:                 ctx,
+		Client:              k8sClient,
+		Force:               true,
+		GracePeriodSeconds:  -1,
+		IgnoreAllDaemonSets: true,
+		DeleteEmptyDirData:  true,
+		Out:                 os.Stdout,
+		ErrOut:              os.Stderr,
+	}
+	for _, group := range groups {
+		for _, member := range append(group.Ready, group.NeedUpdate...) {
+			if member.Node == nil {
+				continue
+			}
+			if err := drainNode(helper, member.Node); err != nil {
+				if options.FailOnDrainError {
+					return err
+				}
+				log.Printf("ignoring drain error on node %s: %v\n", member.Node.Name, err)
+				continue
+			}
+			if err := sleepWithContext(ctx, postDrainDelay); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func drainNode(helper *drain.Helper, node *v1.Node) error {
+	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error cordoning node %s: %v", node.Name, err)
+	}
+	if err := drain.RunNodeDrain(helper, node.Name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error draining node %s: %v", node.Name, err)
+	}
+	return nil
+}
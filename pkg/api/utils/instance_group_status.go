@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+)
+
+// InstanceGroupCloudStatus reports the state of an instance group in the cloud
+type InstanceGroupCloudStatus struct {
+	// Missing indicates that the instance group has no matching group in the cloud
+	Missing bool
+	// CloudGroupId is the id of the group in the cloud (the autoscaling group name on AWS)
+	CloudGroupId string
+	// TargetSize is the number of instances the cloud group is expected to run
+	TargetSize int
+	// ReadyCount is the number of instances that are up to date
+	ReadyCount int
+	// NeedUpdateCount is the number of instances that need to be updated
+	NeedUpdateCount int
+	// Instances contains the instances running in the cloud group
+	Instances []InstanceGroupCloudInstance
+}
+
+// InstanceGroupCloudInstance represents an instance running in a cloud group
+type InstanceGroupCloudInstance struct {
+	// Id is the cloud id of the instance
+	Id string
+	// Status is the cloud status of the instance
+	Status string
+	// NodeName is the name of the kubernetes node backing the instance, if it joined the cluster
+	NodeName string
+}
+
+// clusterNodes lists the nodes of the cluster through its kubernetes api
+func clusterNodes(ctx context.Context, clientset simple.Clientset, clusterName string) ([]v1.Node, error) {
+	configBuilder, err := GetKubeConfigBuilder(ctx, clientset, clusterName, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	config, err := configBuilder.BuildRestConfig()
+	if err != nil {
+		return nil, err
+	}
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build kube client for %q: %v", clusterName, err)
+	}
+	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list nodes of cluster %q: %v", clusterName, err)
+	}
+	if nodeList == nil {
+		return nil, nil
+	}
+	return nodeList.Items, nil
+}
+
+func InstanceGroupStatus(ctx context.Context, clientset simple.Clientset, clusterName, igName string) (*InstanceGroupCloudStatus, error) {
+	kc, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	ig, err := clientset.InstanceGroupsFor(kc).Get(ctx, igName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cloud, err := cloudup.BuildCloud(kc)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := clusterNodes(ctx, clientset, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := cloud.GetCloudGroups(kc, []*kops.InstanceGroup{ig}, false, nodes)
+	if err != nil {
+		return nil, err
+	}
+	// cloud groups are not keyed by instance group name on every cloud provider
+	var group *cloudinstances.CloudInstanceGroup
+	for _, g := range groups {
+		if g.InstanceGroup != nil && g.InstanceGroup.Name == ig.Name {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		return &InstanceGroupCloudStatus{
+			Missing: true,
+		}, nil
+	}
+	status := InstanceGroupCloudStatus{
+		CloudGroupId:    group.HumanName,
+		TargetSize:      group.TargetSize,
+		ReadyCount:      len(group.Ready),
+		NeedUpdateCount: len(group.NeedUpdate),
+	}
+	for _, instances := range [][]*cloudinstances.CloudInstance{group.Ready, group.NeedUpdate} {
+		for _, instance := range instances {
+			i := InstanceGroupCloudInstance{
+				Id:     instance.ID,
+				Status: instance.Status,
+			}
+			if instance.Node != nil {
+				i.NodeName = instance.Node.Name
+			}
+			status.Instances = append(status.Instances, i)
+		}
+	}
+	return &status, nil
+}
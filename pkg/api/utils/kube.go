@@ -9,8 +9,8 @@ import (
 	"k8s.io/kops/pkg/kubeconfig"
 )
 
-func GetKubeConfigBuilder(clientset simple.Clientset, clusterName string, admin *time.Duration, internal bool) (*kubeconfig.KubeconfigBuilder, error) {
-	cluster, err := clientset.GetCluster(context.Background(), clusterName)
+func GetKubeConfigBuilder(ctx context.Context, clientset simple.Clientset, clusterName string, admin *time.Duration, internal bool) (*kubeconfig.KubeconfigBuilder, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
 	if err != nil {
 		return nil, err
 	}
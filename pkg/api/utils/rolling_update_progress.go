@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"k8s.io/kops/pkg/acls"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+const (
+	RollingUpdateInProgress = "InProgress"
+	RollingUpdateCompleted  = "Completed"
+	RollingUpdateFailed     = "Failed"
+)
+
+// rollingUpdateProgressFile is the name of the file holding rolling update progress, next to the cluster config in the state store
+const rollingUpdateProgressFile = "rolling-update-progress.json"
+
+// RollingUpdateProgress holds the progress of a cluster rolling update
+type RollingUpdateProgress struct {
+	// Status is the status of the rolling update (InProgress, Completed or Failed)
+	Status string
+	// StartedAt is the time the rolling update started (RFC3339)
+	StartedAt string
+	// FinishedAt is the time the rolling update completed (RFC3339)
+	FinishedAt string
+	// Resumes is the number of times the rolling update was resumed after being interrupted or failing
+	Resumes int
+	// InstanceGroups contains the names of the instance groups the rolling update is restricted to
+	InstanceGroups []string
+	// InstanceGroupRoles contains the roles of the instance groups the rolling update is restricted to
+	InstanceGroupRoles []string
+	// CompletedInstanceGroups contains the instance groups already rolled
+	CompletedInstanceGroups []string
+	// CurrentInstanceGroup is the instance group being rolled
+	CurrentInstanceGroup string
+	// PendingInstances contains the instances of the current instance group waiting to be replaced
+	PendingInstances []string
+	// ReplacedInstances contains the instances already replaced
+	ReplacedInstances []string
+	// LastError is the error that stopped the rolling update, if any
+	LastError string
+}
+
+func rollingUpdateProgressPath(clientset simple.Clientset, kc *kops.Cluster) (vfs.Path, error) {
+	configBase, err := clientset.ConfigBaseFor(kc)
+	if err != nil {
+		return nil, err
+	}
+	return configBase.Join(rollingUpdateProgressFile), nil
+}
+
+func readRollingUpdateProgress(clientset simple.Clientset, kc *kops.Cluster) (*RollingUpdateProgress, error) {
+	p, err := rollingUpdateProgressPath(clientset, kc)
+	if err != nil {
+		return nil, err
+	}
+	data, err := p.ReadFile()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var progress RollingUpdateProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+func writeRollingUpdateProgress(clientset simple.Clientset, kc *kops.Cluster, progress *RollingUpdateProgress) error {
+	p, err := rollingUpdateProgressPath(clientset, kc)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	acl, err := acls.GetACL(p, kc)
+	if err != nil {
+		return err
+	}
+	return p.WriteFile(bytes.NewReader(data), acl)
+}
+
+// DeleteRollingUpdateProgress removes the rolling update progress of a cluster from the state store, kops refuses to
+// delete a cluster whose config base contains files it does not know about
+func DeleteRollingUpdateProgress(clientset simple.Clientset, kc *kops.Cluster) error {
+	p, err := rollingUpdateProgressPath(clientset, kc)
+	if err != nil {
+		return err
+	}
+	if err := p.Remove(); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// newRollingUpdateProgress starts tracking a rolling update, or resumes the last one if it did not complete and was
+// restricted to the same instance groups, a rolling update of other instance groups starts fresh
+func newRollingUpdateProgress(clientset simple.Clientset, kc *kops.Cluster, instanceGroups []string, instanceGroupRoles []string) (*RollingUpdateProgress, error) {
+	progress, err := readRollingUpdateProgress(clientset, kc)
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil && progress.Status != RollingUpdateCompleted {
+		if sameStrings(progress.InstanceGroups, instanceGroups) && sameStrings(progress.InstanceGroupRoles, instanceGroupRoles) {
+			progress.Status = RollingUpdateInProgress
+			progress.Resumes++
+			return progress, nil
+		}
+		log.Printf("discarding rolling update progress of cluster %s, it was restricted to instance groups %v and roles %v\n", kc.Name, progress.InstanceGroups, progress.InstanceGroupRoles)
+	}
+	return &RollingUpdateProgress{
+		Status:             RollingUpdateInProgress,
+		StartedAt:          time.Now().UTC().Format(time.RFC3339),
+		InstanceGroups:     instanceGroups,
+		InstanceGroupRoles: instanceGroupRoles,
+	}, nil
+}
+
+// sameStrings compares string lists regardless of their order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *RollingUpdateProgress) isCompleted(instanceGroup string) bool {
+	for _, ig := range p.CompletedInstanceGroups {
+		if ig == instanceGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// startInstanceGroup records the instances of the instance group that will be replaced, when resuming the instance group
+// that was in progress, instances that were pending and are not anymore have been replaced by the previous run
+func (p *RollingUpdateProgress) startInstanceGroup(instanceGroup string, pending []string) {
+	if p.CurrentInstanceGroup == instanceGroup {
+		still := map[string]bool{}
+		for _, id := range pending {
+			still[id] = true
+		}
+		for _, id := range p.PendingInstances {
+			if !still[id] {
+				p.ReplacedInstances = append(p.ReplacedInstances, id)
+			}
+		}
+	}
+	p.CurrentInstanceGroup = instanceGroup
+	p.PendingInstances = pending
+}
+
+func (p *RollingUpdateProgress) completeInstanceGroup() {
+	if !p.isCompleted(p.CurrentInstanceGroup) {
+		p.CompletedInstanceGroups = append(p.CompletedInstanceGroups, p.CurrentInstanceGroup)
+	}
+	p.ReplacedInstances = append(p.ReplacedInstances, p.PendingInstances...)
+	p.CurrentInstanceGroup = ""
+	p.PendingInstances = nil
+}
+
+func (p *RollingUpdateProgress) fail(err error) {
+	p.Status = RollingUpdateFailed
+	p.LastError = err.Error()
+}
+
+func (p *RollingUpdateProgress) complete() {
+	p.Status = RollingUpdateCompleted
+	p.FinishedAt = time.Now().UTC().Format(time.RFC3339)
+	p.LastError = ""
+}
+
+// ClusterRollingUpdateProgress returns the progress of the last rolling update of a cluster, nil if no rolling update was recorded
+func ClusterRollingUpdateProgress(ctx context.Context, clientset simple.Clientset, clusterName string) (*RollingUpdateProgress, error) {
+	kc, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	return readRollingUpdateProgress(clientset, kc)
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestNewRollingUpdateProgress(t *testing.T) {
+	stateStore := t.TempDir()
+	clientset := vfsclientset.NewVFSClientset(vfs.NewFSPath(stateStore))
+	cluster := testutils.BuildMinimalCluster("cluster.example.com")
+	cluster.Spec.ConfigBase = "file://" + filepath.Join(stateStore, cluster.Name)
+	kc, err := clientset.CreateCluster(context.Background(), cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a node only rolling update failed after rolling the first node group
+	progress, err := newRollingUpdateProgress(clientset, kc, nil, []string{"Node"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	progress.startInstanceGroup("nodes-0", []string{"i-0"})
+	progress.completeInstanceGroup()
+	progress.startInstanceGroup("nodes-1", []string{"i-1"})
+	progress.fail(fmt.Errorf("failed to drain"))
+	if err := writeRollingUpdateProgress(clientset, kc, progress); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name               string
+		instanceGroups     []string
+		instanceGroupRoles []string
+		resumed            bool
+	}{
+		{name: "same filter", instanceGroupRoles: []string{"Node"}, resumed: true},
+		{name: "other roles", instanceGroupRoles: []string{"Master", "Node"}},
+		{name: "no filter"},
+		{name: "instance groups", instanceGroups: []string{"nodes-1"}, instanceGroupRoles: []string{"Node"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress, err := newRollingUpdateProgress(clientset, kc, tt.instanceGroups, tt.instanceGroupRoles)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resumed := progress.Resumes != 0; resumed != tt.resumed {
+				t.Fatalf("expected resumed to be %v, got %+v", tt.resumed, progress)
+			}
+			if tt.resumed {
+				if !progress.isCompleted("nodes-0") || progress.CurrentInstanceGroup != "nodes-1" {
+					t.Errorf("unexpected resumed progress %+v", progress)
+				}
+			} else if len(progress.CompletedInstanceGroups) != 0 || progress.CurrentInstanceGroup != "" || !sameStrings(progress.InstanceGroupRoles, tt.instanceGroupRoles) {
+				t.Errorf("unexpected fresh progress %+v", progress)
+			}
+		})
+	}
+}
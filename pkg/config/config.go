@@ -3,14 +3,14 @@ package config
 import (
 	"context"
 	"flag"
-	"os"
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
 	configschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +27,9 @@ import (
 )
 
 const (
-	invalidStateError = `Unable to read state store s3 bucket.
-Please use a valid s3 bucket uri on state_store attribute or KOPS_STATE_STORE env var.
-A valid value follows the format s3://<bucket>.
+	invalidStateError = `Unable to read state store bucket.
+Please use a valid s3, gcs, spaces or azure blob uri on state_store attribute or KOPS_STATE_STORE env var.
+A valid value follows the format s3://<bucket>, gs://<bucket>, do://<bucket> or azureblob://<container>.
 Trailing slash will be trimmed.`
 )
 
@@ -45,10 +45,25 @@ func ConfigureProvider(_ context.Context, d *schema.ResourceData) (interface{},
 	if err := initFeatureFlags(providerConfig.FeatureFlags); err != nil {
 		return nil, diag.FromErr(err)
 	}
-	if err := initAwsCredentials(providerConfig.Aws); err != nil {
+	err := initEnvironment(func(env environment) error {
+		if err := initAwsCredentials(providerConfig.Aws, env); err != nil {
+			return err
+		}
+		if err := initOpenstackCredentials(providerConfig.Openstack, env); err != nil {
+			return err
+		}
+		if err := initGceCredentials(providerConfig.Gce, env); err != nil {
+			return err
+		}
+		if err := initDigitalOceanCredentials(providerConfig.DigitalOcean, env); err != nil {
+			return err
+		}
+		return initAzureCredentials(providerConfig.Azure, env)
+	})
+	if err != nil {
 		return nil, diag.FromErr(err)
 	}
-	if err := initOpenstackCredentials(providerConfig.Openstack); err != nil {
+	if err := initAwsEndpoints(providerConfig.Aws); err != nil {
 		return nil, diag.FromErr(err)
 	}
 	if providerConfig.Mock {
@@ -70,65 +85,97 @@ func Clientset(in interface{}) simple.Clientset {
 	return in.(*options).clientset
 }
 
-func setEnvVarSimple(name, value string) {
-	if value != "" {
-		os.Setenv(name, value)
-	}
-}
-
-func initAwsCredentials(config *config.Aws) error {
+func initAwsCredentials(config *config.Aws, env environment) error {
 	if config == nil {
 		return nil
 	}
-	setEnvVarSimple("AWS_DEFAULT_REGION", config.Region)
-	setEnvVarSimple("AWS_ACCESS_KEY_ID", config.AccessKey)
-	setEnvVarSimple("AWS_SECRET_ACCESS_KEY", config.SecretKey)
-	setEnvVarSimple("S3_ENDPOINT", config.S3Endpoint)
-	setEnvVarSimple("S3_REGION", config.S3Region)
-	setEnvVarSimple("S3_ACCESS_KEY_ID", config.S3AccessKey)
-	setEnvVarSimple("S3_SECRET_ACCESS_KEY", config.S3SecretKey)
+	env.set("AWS_DEFAULT_REGION", config.Region)
+	env.set("AWS_ACCESS_KEY_ID", config.AccessKey)
+	env.set("AWS_SECRET_ACCESS_KEY", config.SecretKey)
+	env.set("S3_ENDPOINT", config.S3Endpoint)
+	env.set("S3_REGION", config.S3Region)
+	env.set("S3_ACCESS_KEY_ID", config.S3AccessKey)
+	env.set("S3_SECRET_ACCESS_KEY", config.S3SecretKey)
 	if config.SkipRegionCheck {
-		os.Setenv("SKIP_REGION_CHECK", "1")
+		env.set("SKIP_REGION_CHECK", "1")
 	}
 	if config.Profile != "" {
-		os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
-		os.Setenv("AWS_PROFILE", config.Profile)
+		env.set("AWS_SDK_LOAD_CONFIG", "1")
+		env.set("AWS_PROFILE", config.Profile)
 	}
 	if config.AssumeRole != nil {
-		svc := sts.New(session.New())
-		input := &sts.AssumeRoleInput{
-			RoleArn:         aws.String(config.AssumeRole.RoleArn),
-			RoleSessionName: aws.String("TF-PROVIDER-KOPS"),
-		}
-		result, err := svc.AssumeRole(input)
-		if err != nil {
-			return err
+		return initAwsAssumeRole(config, env)
+	}
+	return nil
+}
+
+// awsSession builds a session from the provider configuration, without relying on the process environment
+// for the settings the configuration defines
+func awsSession(config *config.Aws) (*session.Session, error) {
+	options := session.Options{
+		Profile: config.Profile,
+	}
+	if config.Region != "" {
+		options.Config.Region = aws.String(config.Region)
+	}
+	if config.AccessKey != "" || config.SecretKey != "" {
+		options.Config.Credentials = credentials.NewStaticCredentials(config.AccessKey, config.SecretKey, "")
+	}
+	if config.Profile != "" {
+		options.SharedConfigState = session.SharedConfigEnable
+	}
+	return session.NewSessionWithOptions(options)
+}
+
+func initOpenstackCredentials(config *config.Openstack, env environment) error {
+	if config == nil {
+		return nil
+	}
+	env.set("OS_TENANT_ID", config.TenantId)
+	env.set("OS_TENANT_NAME", config.TenantName)
+	env.set("OS_PROJECT_ID", config.ProjectId)
+	env.set("OS_PROJECT_NAME", config.ProjectName)
+	env.set("OS_PROJECT_DOMAIN_NAME", config.ProjectDomainName)
+	env.set("OS_PROJECT_DOMAIN_ID", config.ProjectDomainId)
+	env.set("OS_DOMAIN_NAME", config.DomainName)
+	env.set("OS_DOMAIN_ID", config.DomainId)
+	env.set("OS_USERNAME", config.Username)
+	env.set("OS_PASSWORD", config.Password)
+	env.set("OS_AUTH_URL", config.AuthUrl)
+	env.set("OS_REGION_NAME", config.RegionName)
+	env.set("OS_APPLICATION_CREDENTIAL_ID", config.ApplicationCredentialId)
+	env.set("OS_APPLICATION_CREDENTIAL_SECRET", config.ApplicationCredentialSecret)
+	return nil
+}
+
+// initDigitalOceanCredentials configures the digitalocean api token, spaces are accessed with the s3 compatible api
+// and share the s3 settings of the aws block
+func initDigitalOceanCredentials(config *config.DigitalOcean, env environment) error {
+	if config == nil {
+		return nil
+	}
+	env.set("DIGITALOCEAN_ACCESS_TOKEN", config.AccessToken)
+	if config.SpacesEndpoint != "" || config.SpacesAccessKey != "" || config.SpacesSecretKey != "" {
+		if env["S3_ENDPOINT"] != "" || env["S3_ACCESS_KEY_ID"] != "" || env["S3_SECRET_ACCESS_KEY"] != "" {
+			return fmt.Errorf("digitalocean spaces settings cannot be used together with aws s3 settings")
 		}
-		os.Setenv("AWS_ACCESS_KEY_ID", *result.Credentials.AccessKeyId)
-		os.Setenv("AWS_SECRET_ACCESS_KEY", *result.Credentials.SecretAccessKey)
-		os.Setenv("AWS_SESSION_TOKEN", *result.Credentials.SessionToken)
+		env.set("S3_ENDPOINT", config.SpacesEndpoint)
+		env.set("S3_ACCESS_KEY_ID", config.SpacesAccessKey)
+		env.set("S3_SECRET_ACCESS_KEY", config.SpacesSecretKey)
 	}
 	return nil
 }
 
-func initOpenstackCredentials(config *config.Openstack) error {
+func initAzureCredentials(config *config.Azure, env environment) error {
 	if config == nil {
 		return nil
 	}
-	setEnvVarSimple("OS_TENANT_ID", config.TenantId)
-	setEnvVarSimple("OS_TENANT_NAME", config.TenantName)
-	setEnvVarSimple("OS_PROJECT_ID", config.ProjectId)
-	setEnvVarSimple("OS_PROJECT_NAME", config.ProjectName)
-	setEnvVarSimple("OS_PROJECT_DOMAIN_NAME", config.ProjectDomainName)
-	setEnvVarSimple("OS_PROJECT_DOMAIN_ID", config.ProjectDomainId)
-	setEnvVarSimple("OS_DOMAIN_NAME", config.DomainName)
-	setEnvVarSimple("OS_DOMAIN_ID", config.DomainId)
-	setEnvVarSimple("OS_USERNAME", config.Username)
-	setEnvVarSimple("OS_PASSWORD", config.Password)
-	setEnvVarSimple("OS_AUTH_URL", config.AuthUrl)
-	setEnvVarSimple("OS_REGION_NAME", config.RegionName)
-	setEnvVarSimple("OS_APPLICATION_CREDENTIAL_ID", config.ApplicationCredentialId)
-	setEnvVarSimple("OS_APPLICATION_CREDENTIAL_SECRET", config.ApplicationCredentialSecret)
+	env.set("AZURE_TENANT_ID", config.TenantId)
+	env.set("AZURE_SUBSCRIPTION_ID", config.SubscriptionId)
+	env.set("AZURE_CLIENT_ID", config.ClientId)
+	env.set("AZURE_CLIENT_SECRET", config.ClientSecret)
+	env.set("AZURE_STORAGE_ACCOUNT", config.StorageAccount)
+	env.set("AZURE_STORAGE_KEY", config.StorageKey)
 	return nil
 }
 
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+)
+
+const (
+	defaultRoleSessionName = "TF-PROVIDER-KOPS"
+	// credentials are refreshed before the aws sdk clients consider them expired (5 minutes)
+	assumeRoleExpiryWindow = 10 * time.Minute
+)
+
+// assumeRoleProvider retrieves temporary credentials for a role, with web identity when a token is configured
+type assumeRoleProvider struct {
+	credentials.Expiry
+	sts    stsiface.STSAPI
+	config *config.AwsAssumeRole
+}
+
+func (p *assumeRoleProvider) Retrieve() (credentials.Value, error) {
+	c := p.config
+	sessionName := c.SessionName
+	if sessionName == "" {
+		sessionName = defaultRoleSessionName
+	}
+	var duration *int64
+	if c.Duration != nil {
+		duration = aws.Int64(int64(c.Duration.Duration.Seconds()))
+	}
+	var policy *string
+	if c.Policy != "" {
+		policy = aws.String(c.Policy)
+	}
+	var policyArns []*sts.PolicyDescriptorType
+	for _, arn := range c.PolicyArns {
+		policyArns = append(policyArns, &sts.PolicyDescriptorType{Arn: aws.String(arn)})
+	}
+	var creds *sts.Credentials
+	if c.WebIdentityToken != "" || c.WebIdentityTokenFile != "" {
+		token := c.WebIdentityToken
+		if c.WebIdentityTokenFile != "" {
+			data, err := os.ReadFile(c.WebIdentityTokenFile)
+			if err != nil {
+				return credentials.Value{}, fmt.Errorf("failed to read web identity token file: %v", err)
+			}
+			token = strings.TrimSpace(string(data))
+		}
+		out, err := p.sts.AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+			RoleArn:          aws.String(c.RoleArn),
+			RoleSessionName:  aws.String(sessionName),
+			WebIdentityToken: aws.String(token),
+			DurationSeconds:  duration,
+			Policy:           policy,
+			PolicyArns:       policyArns,
+		})
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		creds = out.Credentials
+	} else {
+		var tags []*sts.Tag
+		for k, v := range c.Tags {
+			tags = append(tags, &sts.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		input := &sts.AssumeRoleInput{
+			RoleArn:         aws.String(c.RoleArn),
+			RoleSessionName: aws.String(sessionName),
+			DurationSeconds: duration,
+			Policy:          policy,
+			PolicyArns:      policyArns,
+			Tags:            tags,
+		}
+		if c.ExternalId != "" {
+			input.ExternalId = aws.String(c.ExternalId)
+		}
+		if c.SourceIdentity != "" {
+			input.SourceIdentity = aws.String(c.SourceIdentity)
+		}
+		out, err := p.sts.AssumeRole(input)
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		creds = out.Credentials
+	}
+	p.SetExpiration(aws.TimeValue(creds.Expiration), assumeRoleExpiryWindow)
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    "AssumeRoleProvider",
+	}, nil
+}
+
+// validateAssumeRole rejects settings that are not supported when assuming a role with web identity
+func validateAssumeRole(config *config.AwsAssumeRole) error {
+	if config.WebIdentityToken == "" && config.WebIdentityTokenFile == "" {
+		return nil
+	}
+	if config.ExternalId != "" || len(config.Tags) != 0 || config.SourceIdentity != "" {
+		return fmt.Errorf("external_id, tags and source_identity cannot be used when assuming a role with web identity")
+	}
+	return nil
+}
+
+func newAssumeRoleCredentials(sess *session.Session, stsConfig *aws.Config, config *config.AwsAssumeRole) *credentials.Credentials {
+	return credentials.NewCredentials(&assumeRoleProvider{
+		sts:    sts.New(sess, stsConfig),
+		config: config,
+	})
+}
+
+// credentialsHandler serves credentials using the container credentials protocol, kops creates its aws clients from the
+// environment and caches them, pointing AWS_CONTAINER_CREDENTIALS_FULL_URI to this handler lets those clients refresh
+// credentials for as long as the provider runs
+type credentialsHandler struct {
+	token string
+	creds *credentials.Credentials
+}
+
+type credentialsOutput struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	Token           string
+	Expiration      *time.Time
+}
+
+func (h *credentialsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != h.token {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	value, err := h.creds.Get()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"code": "CredentialsError", "message": err.Error()})
+		return
+	}
+	out := credentialsOutput{
+		AccessKeyId:     value.AccessKeyID,
+		SecretAccessKey: value.SecretAccessKey,
+		Token:           value.SessionToken,
+	}
+	if expiration, err := h.creds.ExpiresAt(); err == nil {
+		out.Expiration = &expiration
+	}
+	json.NewEncoder(w).Encode(out)
+}
+
+// initAwsAssumeRole makes aws clients created with env use refreshable credentials of the assumed role
+func initAwsAssumeRole(config *config.Aws, env environment) error {
+	if err := validateAssumeRole(config.AssumeRole); err != nil {
+		return err
+	}
+	sess, err := awsSession(config)
+	if err != nil {
+		return err
+	}
+	stsConfig := aws.NewConfig()
+	if config.Endpoints != nil && config.Endpoints.Sts != "" {
+		stsConfig = stsConfig.WithEndpoint(config.Endpoints.Sts)
+	}
+	creds := newAssumeRoleCredentials(sess, stsConfig, config.AssumeRole)
+	// fail early when the role cannot be assumed
+	if _, err := creds.Get(); err != nil {
+		return err
+	}
+	token, err := loopbackSecret()
+	if err != nil {
+		return err
+	}
+	url, err := loopback.register(&credentialsHandler{
+		token: token,
+		creds: creds,
+	})
+	if err != nil {
+		return err
+	}
+	// credentials from the environment, profiles and web identity take precedence over the container credentials endpoint
+	for _, name := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", "AWS_SDK_LOAD_CONFIG", "AWS_ROLE_ARN", "AWS_WEB_IDENTITY_TOKEN_FILE", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"} {
+		env.unset(name)
+	}
+	env.set("AWS_SHARED_CREDENTIALS_FILE", os.DevNull)
+	env.set("AWS_CONTAINER_CREDENTIALS_FULL_URI", url)
+	env.set("AWS_CONTAINER_AUTHORIZATION_TOKEN", token)
+	return nil
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+)
+
+func TestCredentialsHandler(t *testing.T) {
+	server := httptest.NewServer(&credentialsHandler{
+		token: "secret",
+		creds: credentials.NewStaticCredentials("access", "secret-access", "session"),
+	})
+	defer server.Close()
+	// credentials are retrieved the way aws sdk clients do with AWS_CONTAINER_CREDENTIALS_FULL_URI
+	get := func(token string) (credentials.Value, error) {
+		creds := endpointcreds.NewCredentialsClient(*defaults.Config(), defaults.Handlers(), server.URL, func(p *endpointcreds.Provider) {
+			p.AuthorizationToken = token
+		})
+		return creds.Get()
+	}
+	value, err := get("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.AccessKeyID != "access" || value.SecretAccessKey != "secret-access" || value.SessionToken != "session" {
+		t.Errorf("unexpected credentials %+v", value)
+	}
+	if _, err := get("invalid"); err == nil {
+		t.Error("expected an invalid authorization token to be rejected")
+	}
+}
+
+func TestValidateAssumeRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  config.AwsAssumeRole
+		wantErr bool
+	}{
+		{
+			name:   "assume role",
+			config: config.AwsAssumeRole{RoleArn: "arn", ExternalId: "id", Tags: map[string]string{"k": "v"}, SourceIdentity: "me"},
+		},
+		{
+			name:   "web identity",
+			config: config.AwsAssumeRole{RoleArn: "arn", WebIdentityTokenFile: "/token", SessionName: "ci"},
+		},
+		{
+			name:    "web identity with external id",
+			config:  config.AwsAssumeRole{RoleArn: "arn", WebIdentityToken: "token", ExternalId: "id"},
+			wantErr: true,
+		},
+		{
+			name:    "web identity with tags",
+			config:  config.AwsAssumeRole{RoleArn: "arn", WebIdentityTokenFile: "/token", Tags: map[string]string{"k": "v"}},
+			wantErr: true,
+		},
+		{
+			name:    "web identity with source identity",
+			config:  config.AwsAssumeRole{RoleArn: "arn", WebIdentityToken: "token", SourceIdentity: "me"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAssumeRole(&tt.config); (err != nil) != tt.wantErr {
+				t.Errorf("validateAssumeRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+)
+
+// elbv2Version is the api version sent by elbv2 requests, elb and elbv2 share the same signing name and default endpoint
+const elbv2Version = "Version=2015-12-01"
+
+// endpointsTransport sends the requests of aws services to their custom endpoint. The aws sdk only takes endpoints from
+// the config of a client, kops creates its clients (sts included) from sessions it builds for every region without a way
+// to configure them. Those sessions send requests with http.DefaultClient, the transport is registered there for the http
+// and https schemes, the aws sdk requires http.DefaultClient to keep using an *http.Transport to load custom ca bundles.
+type endpointsTransport struct {
+	mutex     sync.RWMutex
+	endpoints map[string]*url.URL
+	transport *http.Transport
+}
+
+var awsEndpoints endpointsTransport
+
+// set replaces the custom endpoints, keyed by signing name, the transport is registered the first time endpoints are set
+func (t *endpointsTransport) set(endpoints map[string]*url.URL) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.endpoints = endpoints
+	if t.transport != nil || len(endpoints) == 0 {
+		return nil
+	}
+	switch transport := http.DefaultClient.Transport.(type) {
+	case nil:
+		t.transport = http.DefaultTransport.(*http.Transport).Clone()
+		http.DefaultClient.Transport = t.transport
+	case *http.Transport:
+		t.transport = transport
+	default:
+		return fmt.Errorf("custom aws endpoints cannot be used with the http transport %T", transport)
+	}
+	t.transport.RegisterProtocol("http", t)
+	t.transport.RegisterProtocol("https", t)
+	return nil
+}
+
+// routedKey marks the requests already sent to their endpoint in their context
+type routedKey struct{}
+
+// signingName returns the signing name of a request signed with aws signature v4
+func signingName(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	i := strings.Index(auth, "Credential=")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 ") || i < 0 {
+		return ""
+	}
+	// Credential=<access key>/<date>/<region>/<service>/aws4_request
+	scope := strings.Split(strings.SplitN(auth[i+len("Credential="):], ",", 2)[0], "/")
+	if len(scope) != 5 {
+		return ""
+	}
+	return scope[3]
+}
+
+// RoundTrip sends the request to its custom endpoint, other requests are left to the transport
+func (t *endpointsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.mutex.RLock()
+	endpoints := t.endpoints
+	t.mutex.RUnlock()
+	if len(endpoints) == 0 || r.Context().Value(routedKey{}) != nil {
+		return nil, http.ErrSkipAltProtocol
+	}
+	service := signingName(r)
+	out := r.Clone(context.WithValue(r.Context(), routedKey{}, true))
+	if service == "elasticloadbalancing" && (endpoints["elasticloadbalancing"] != nil || endpoints["elbv2"] != nil) {
+		// the request body is read to tell elb and elbv2 requests apart, the request cannot be left to the transport anymore
+		if r.Body != nil {
+			body, err := ioutil.ReadAll(r.Body)
+			r.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			out.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if bytes.Contains(body, []byte(elbv2Version)) {
+				service = "elbv2"
+			}
+		}
+	} else if endpoints[service] == nil {
+		return nil, http.ErrSkipAltProtocol
+	}
+	if endpoint := endpoints[service]; endpoint != nil {
+		out.URL.Scheme = endpoint.Scheme
+		out.URL.Host = endpoint.Host
+		out.URL.Path = strings.TrimSuffix(endpoint.Path, "/") + r.URL.Path
+		out.URL.RawPath = ""
+		// the request keeps the host it was signed for
+		out.Host = r.URL.Host
+	}
+	return t.transport.RoundTrip(out)
+}
+
+// initAwsEndpoints routes the requests of the aws clients of every region to the configured endpoints
+func initAwsEndpoints(config *config.Aws) error {
+	endpoints := map[string]*url.URL{}
+	if config != nil && config.Endpoints != nil {
+		for service, endpoint := range map[string]string{
+			"ec2":                  config.Endpoints.Ec2,
+			"iam":                  config.Endpoints.Iam,
+			"elasticloadbalancing": config.Endpoints.Elb,
+			"elbv2":                config.Endpoints.Elbv2,
+			"route53":              config.Endpoints.Route53,
+			"autoscaling":          config.Endpoints.Autoscaling,
+			"sts":                  config.Endpoints.Sts,
+			"sqs":                  config.Endpoints.Sqs,
+		} {
+			if endpoint == "" {
+				continue
+			}
+			// like the aws sdk, endpoints without scheme use https
+			if !strings.Contains(endpoint, "://") {
+				endpoint = "https://" + endpoint
+			}
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return fmt.Errorf("invalid %s endpoint %q: %v", service, endpoint, err)
+			}
+			endpoints[service] = u
+		}
+	}
+	return awsEndpoints.set(endpoints)
+}
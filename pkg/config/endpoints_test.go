@@ -0,0 +1,185 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// awsResponses contains the responses of the actions awsServer does not deny
+var awsResponses = map[string]string{
+	"DescribeVpcs":    `<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><vpcSet/></DescribeVpcsResponse>`,
+	"DescribeRegions": `<DescribeRegionsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><regionInfo><item><regionName>us-mock-1</regionName></item></regionInfo></DescribeRegionsResponse>`,
+	"DescribeAvailabilityZones": `<DescribeAvailabilityZonesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><availabilityZoneInfo>` +
+		`<item><zoneName>us-mock-1a</zoneName><zoneState>available</zoneState><regionName>us-mock-1</regionName></item>` +
+		`<item><zoneName>us-mock-1b</zoneName><zoneState>available</zoneState><regionName>us-mock-1</regionName></item>` +
+		`<item><zoneName>us-mock-1c</zoneName><zoneState>available</zoneState><regionName>us-mock-1</regionName></item>` +
+		`</availabilityZoneInfo></DescribeAvailabilityZonesResponse>`,
+	"DescribeImages": `<DescribeImagesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><imagesSet><item>` +
+		`<imageId>ami-12345678</imageId><creationDate>2021-01-01T00:00:00.000Z</creationDate><architecture>x86_64</architecture><rootDeviceName>/dev/xvda</rootDeviceName>` +
+		`</item></imagesSet></DescribeImagesResponse>`,
+	"DescribeInstanceTypes": `<DescribeInstanceTypesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><instanceTypeSet><item>` +
+		`<instanceType>t3.medium</instanceType><processorInfo><supportedArchitectures><item>x86_64</item></supportedArchitectures></processorInfo>` +
+		`<vCpuInfo><defaultVCpus>2</defaultVCpus></vCpuInfo><memoryInfo><sizeInMiB>4096</sizeInMiB></memoryInfo>` +
+		`</item></instanceTypeSet></DescribeInstanceTypesResponse>`,
+}
+
+// awsServer records the actions it receives and answers them with an error, except awsResponses
+type awsServer struct {
+	*httptest.Server
+	mutex   sync.Mutex
+	actions []string
+	hosts   []string
+}
+
+func newAwsServer(t *testing.T) *awsServer {
+	s := &awsServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.actions = append(s.actions, r.PostFormValue("Action"))
+		s.hosts = append(s.hosts, r.Host)
+		s.mutex.Unlock()
+		w.Header().Set("Content-Type", "text/xml")
+		if response, ok := awsResponses[r.PostFormValue("Action")]; ok {
+			w.Write([]byte(response))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		if strings.HasPrefix(r.Host, "ec2.") {
+			w.Write([]byte(`<Response><Errors><Error><Code>AccessDenied</Code><Message>denied by test</Message></Error></Errors></Response>`))
+			return
+		}
+		w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied by test</Message></Error></ErrorResponse>`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *awsServer) received() ([]string, []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string(nil), s.actions...), append([]string(nil), s.hosts...)
+}
+
+// resetAwsEndpoints restores the default endpoints once the test completes
+func resetAwsEndpoints(t *testing.T) {
+	t.Cleanup(func() {
+		initAwsEndpoints(nil)
+	})
+}
+
+func TestInitAwsEndpoints(t *testing.T) {
+	setTestEnv(t, "AWS_ACCESS_KEY_ID", "access")
+	setTestEnv(t, "AWS_SECRET_ACCESS_KEY", "secret")
+	setTestEnv(t, "SKIP_REGION_CHECK", "1")
+	resetAwsEndpoints(t)
+	ec2Server, stsServer, elbServer, elbv2Server := newAwsServer(t), newAwsServer(t), newAwsServer(t), newAwsServer(t)
+	err := initAwsEndpoints(&config.Aws{Endpoints: &config.AwsEndpoints{
+		Ec2:   ec2Server.URL,
+		Sts:   stsServer.URL,
+		Elb:   elbServer.URL,
+		Elbv2: elbv2Server.URL,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// kops caches aws clouds per region for the lifetime of the process, the regions are not used by other tests
+	for _, region := range []string{"us-endpoints-1", "eu-endpoints-1"} {
+		cloud, err := awsup.NewAWSCloud(region, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cloud.EC2().DescribeVpcs(&ec2.DescribeVpcsInput{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := cloud.AccountInfo(); err == nil || !strings.Contains(err.Error(), "denied by test") {
+			t.Errorf("expected the account to be looked up through the sts endpoint, got %v", err)
+		}
+		cloud.ELB().DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{})
+		cloud.ELBV2().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{})
+	}
+	for _, tt := range []struct {
+		name    string
+		server  *awsServer
+		action  string
+		service string
+	}{
+		{"ec2", ec2Server, "DescribeVpcs", "ec2"},
+		{"sts", stsServer, "GetCallerIdentity", "sts"},
+		{"elb", elbServer, "DescribeLoadBalancers", "elasticloadbalancing"},
+		{"elbv2", elbv2Server, "DescribeLoadBalancers", "elasticloadbalancing"},
+	} {
+		actions, hosts := tt.server.received()
+		if len(actions) != 2 || actions[0] != tt.action || actions[1] != tt.action {
+			t.Errorf("%s: expected the endpoint to receive %s for every region, got %v", tt.name, tt.action, actions)
+		}
+		// requests keep the host they were signed for
+		for _, host := range hosts {
+			if !strings.HasPrefix(host, tt.service+".") || !strings.HasSuffix(host, ".amazonaws.com") {
+				t.Errorf("%s: unexpected host %s", tt.name, host)
+			}
+		}
+	}
+}
+
+func TestAwsEndpointsApply(t *testing.T) {
+	setTestEnv(t, "AWS_ACCESS_KEY_ID", "access")
+	setTestEnv(t, "AWS_SECRET_ACCESS_KEY", "secret")
+	setTestEnv(t, "SKIP_REGION_CHECK", "1")
+	resetAwsEndpoints(t)
+	ec2Server, stsServer := newAwsServer(t), newAwsServer(t)
+	// kubernetes and kops assets are hashed during the apply
+	assets := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("0", 64)))
+	}))
+	defer assets.Close()
+	setTestEnv(t, "KOPS_BASE_URL", assets.URL+"/kops/")
+	setTestEnv(t, "CNI_VERSION_URL", assets.URL+"/cni/cni-plugins.tgz")
+	setTestEnv(t, "CNI_ASSET_HASH_STRING", strings.Repeat("0", 64))
+	if err := initAwsEndpoints(&config.Aws{Endpoints: &config.AwsEndpoints{Ec2: ec2Server.URL, Sts: stsServer.URL}}); err != nil {
+		t.Fatal(err)
+	}
+	vfs.Context.ResetMemfsContext(true)
+	ctx := context.Background()
+	clientset := vfsclientset.NewVFSClientset(vfs.NewMemFSPath(vfs.NewMemFSContext(), "state"))
+	cluster := testutils.BuildMinimalCluster("cluster.example.com")
+	cluster.Spec.Channel = "none"
+	cluster.Spec.KubernetesVersion = assets.URL + "/kubernetes/v1.21.0"
+	kc, err := clientset.CreateCluster(ctx, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+	igs := []kops.InstanceGroup{testutils.BuildMinimalNodeInstanceGroup("nodes", cluster.Spec.Subnets[0].Name)}
+	for _, subnet := range cluster.Spec.Subnets {
+		igs = append(igs, testutils.BuildMinimalMasterInstanceGroup(subnet.Name))
+	}
+	for _, ig := range igs {
+		ig.Spec.MachineType = "t3.medium"
+		ig.Spec.Image = "ami-12345678"
+		if _, err := clientset.InstanceGroupsFor(kc).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = utils.ClusterApply(ctx, clientset, cluster.Name, utils.ApplyOptions{})
+	if err == nil || !strings.Contains(err.Error(), "denied by test") {
+		t.Errorf("expected the apply to look the account up through the sts endpoint, got %v", err)
+	}
+	if actions, _ := stsServer.received(); len(actions) != 1 || actions[0] != "GetCallerIdentity" {
+		t.Errorf("expected the sts endpoint to receive GetCallerIdentity, got %v", actions)
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"sync"
+)
+
+// environment holds the environment variables kops needs to reach the cloud and the state store
+type environment map[string]string
+
+func (e environment) set(name, value string) {
+	if value != "" {
+		e[name] = value
+	}
+}
+
+// unset removes a variable from the process environment when the environment is applied
+func (e environment) unset(name string) {
+	e[name] = ""
+}
+
+func (e environment) apply() {
+	for k, v := range e {
+		if v != "" {
+			os.Setenv(k, v)
+		} else {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+// processEnvMutex serializes changes to the process environment and to tempFiles
+var processEnvMutex sync.Mutex
+
+// tempFiles contains the files written while building the environment, they are removed by Cleanup
+var tempFiles []string
+
+// Cleanup removes the files written while configuring the provider, it is called when the provider stops serving
+func Cleanup() {
+	processEnvMutex.Lock()
+	defer processEnvMutex.Unlock()
+	for _, path := range tempFiles {
+		os.Remove(path)
+	}
+	tempFiles = nil
+}
+
+// initEnvironment builds the environment with init and applies it to the process, nothing is applied if init fails
+func initEnvironment(init func(environment) error) error {
+	processEnvMutex.Lock()
+	defer processEnvMutex.Unlock()
+	env := environment{}
+	if err := init(env); err != nil {
+		return err
+	}
+	env.apply()
+	return nil
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+// setTestEnv sets an environment variable for the duration of the test
+func setTestEnv(t *testing.T, name, value string) {
+	old, ok := os.LookupEnv(name)
+	os.Setenv(name, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(name, old)
+		} else {
+			os.Unsetenv(name)
+		}
+	})
+}
+
+func TestEnvironmentApply(t *testing.T) {
+	setTestEnv(t, "TF_KOPS_TEST_SET", "old")
+	setTestEnv(t, "TF_KOPS_TEST_KEPT", "kept")
+	setTestEnv(t, "TF_KOPS_TEST_UNSET", "old")
+	env := environment{}
+	env.set("TF_KOPS_TEST_SET", "new")
+	env.set("TF_KOPS_TEST_KEPT", "")
+	env.unset("TF_KOPS_TEST_UNSET")
+	env.apply()
+	if v := os.Getenv("TF_KOPS_TEST_SET"); v != "new" {
+		t.Errorf("expected variable to be set, got %q", v)
+	}
+	if v := os.Getenv("TF_KOPS_TEST_KEPT"); v != "kept" {
+		t.Errorf("expected variable set to an empty value to be left untouched, got %q", v)
+	}
+	if _, ok := os.LookupEnv("TF_KOPS_TEST_UNSET"); ok {
+		t.Error("expected variable to be unset")
+	}
+}
+
+func TestInitEnvironment(t *testing.T) {
+	setTestEnv(t, "TF_KOPS_TEST", "")
+	if err := initEnvironment(func(env environment) error {
+		env.set("TF_KOPS_TEST", "broken")
+		return errors.New("broken")
+	}); err == nil {
+		t.Fatal("expected init error")
+	}
+	if v := os.Getenv("TF_KOPS_TEST"); v != "" {
+		t.Errorf("expected environment of a failed init not to be applied, got %q", v)
+	}
+	if err := initEnvironment(func(env environment) error {
+		env.set("TF_KOPS_TEST", "applied")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if v := os.Getenv("TF_KOPS_TEST"); v != "applied" {
+		t.Errorf("expected environment to be applied, got %q", v)
+	}
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+)
+
+const (
+	gceScope = "https://www.googleapis.com/auth/cloud-platform"
+	// lifetime of the access tokens generated for an impersonated service account
+	gceImpersonationLifetime = time.Hour
+)
+
+// impersonatedTokenSource generates access tokens for a service account using the iam credentials api
+type impersonatedTokenSource struct {
+	ctx            context.Context
+	service        *iamcredentials.Service
+	serviceAccount string
+}
+
+func (s *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	name := "projects/-/serviceAccounts/" + s.serviceAccount
+	out, err := s.service.Projects.ServiceAccounts.GenerateAccessToken(name, &iamcredentials.GenerateAccessTokenRequest{
+		Scope:    []string{gceScope},
+		Lifetime: strconv.Itoa(int(gceImpersonationLifetime.Seconds())) + "s",
+	}).Context(s.ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to impersonate service account %s: %v", s.serviceAccount, err)
+	}
+	expiry, err := time.Parse(time.RFC3339, out.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{
+		AccessToken: out.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
+
+// tokenHandler serves access tokens as an oauth2 token endpoint, kops only loads google credentials from
+// GOOGLE_APPLICATION_CREDENTIALS and caches its clients, pointing it to an authorized_user file whose token_uri is this
+// handler lets those clients use inline or impersonated credentials
+type tokenHandler struct {
+	refreshToken string
+	source       oauth2.TokenSource
+}
+
+type tokenOutput struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+}
+
+func (h *tokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.PostFormValue("grant_type") != "refresh_token" || r.PostFormValue("refresh_token") != h.refreshToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+	token, err := h.source.Token()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": "server_error", "error_description": err.Error()})
+		return
+	}
+	out := tokenOutput{
+		AccessToken: token.AccessToken,
+		TokenType:   "Bearer",
+	}
+	if !token.Expiry.IsZero() {
+		out.ExpiresIn = int64(time.Until(token.Expiry).Seconds())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
+
+// gceTokenSourceFor returns the token source described by the provider configuration
+func gceTokenSourceFor(ctx context.Context, config *config.Gce) (oauth2.TokenSource, error) {
+	var creds *google.Credentials
+	var err error
+	switch {
+	case config.Credentials != "":
+		creds, err = google.CredentialsFromJSON(ctx, []byte(config.Credentials), gceScope)
+	case config.CredentialsFile != "":
+		var data []byte
+		data, err = os.ReadFile(config.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gce credentials file: %v", err)
+		}
+		creds, err = google.CredentialsFromJSON(ctx, data, gceScope)
+	default:
+		creds, err = google.FindDefaultCredentials(ctx, gceScope)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.ImpersonateServiceAccount == "" {
+		return creds.TokenSource, nil
+	}
+	service, err := iamcredentials.NewService(ctx, option.WithTokenSource(creds.TokenSource))
+	if err != nil {
+		return nil, err
+	}
+	return &impersonatedTokenSource{
+		ctx:            ctx,
+		service:        service,
+		serviceAccount: config.ImpersonateServiceAccount,
+	}, nil
+}
+
+// writeGceCredentialsFile writes an authorized_user credentials file retrieving tokens from the token server
+func writeGceCredentialsFile(tokenURL, refreshToken string) (string, error) {
+	f, err := os.CreateTemp("", "terraform-provider-kops-gce-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	err = f.Chmod(0600)
+	if err == nil {
+		err = json.NewEncoder(f).Encode(map[string]string{
+			"type":          "authorized_user",
+			"client_id":     "terraform-provider-kops",
+			"client_secret": "terraform-provider-kops",
+			"refresh_token": refreshToken,
+			"token_uri":     tokenURL,
+		})
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func initGceCredentials(config *config.Gce, env environment) error {
+	if config == nil {
+		return nil
+	}
+	env.set("GOOGLE_CLOUD_PROJECT", config.Project)
+	env.set("CLOUDSDK_CORE_PROJECT", config.Project)
+	env.set("CLOUDSDK_COMPUTE_REGION", config.Region)
+	if config.Credentials == "" && config.ImpersonateServiceAccount == "" {
+		env.set("GOOGLE_APPLICATION_CREDENTIALS", config.CredentialsFile)
+	} else {
+		ctx := context.Background()
+		source, err := gceTokenSourceFor(ctx, config)
+		if err != nil {
+			return err
+		}
+		source = oauth2.ReuseTokenSource(nil, source)
+		// fail early when credentials are invalid or the service account cannot be impersonated
+		if _, err := source.Token(); err != nil {
+			return err
+		}
+		refreshToken, err := loopbackSecret()
+		if err != nil {
+			return err
+		}
+		tokenURL, err := loopback.register(&tokenHandler{
+			refreshToken: refreshToken,
+			source:       source,
+		})
+		if err != nil {
+			return err
+		}
+		path, err := writeGceCredentialsFile(tokenURL, refreshToken)
+		if err != nil {
+			return err
+		}
+		tempFiles = append(tempFiles, path)
+		env.set("GOOGLE_APPLICATION_CREDENTIALS", path)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestTokenHandler(t *testing.T) {
+	server := httptest.NewServer(&tokenHandler{
+		refreshToken: "secret",
+		source:       oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)}),
+	})
+	defer server.Close()
+	// tokens are retrieved the way google clients do with an authorized_user credentials file
+	get := func(refreshToken string) (*oauth2.Token, error) {
+		config := oauth2.Config{
+			ClientID:     "terraform-provider-kops",
+			ClientSecret: "terraform-provider-kops",
+			Endpoint:     oauth2.Endpoint{TokenURL: server.URL},
+		}
+		return config.TokenSource(context.Background(), &oauth2.Token{RefreshToken: refreshToken}).Token()
+	}
+	token, err := get("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access" || token.Expiry.IsZero() {
+		t.Errorf("unexpected token %+v", token)
+	}
+	if _, err := get("invalid"); err == nil {
+		t.Error("expected an invalid refresh token to be rejected")
+	}
+}
+
+func TestGceCredentialsFileCleanup(t *testing.T) {
+	path, err := writeGceCredentialsFile("http://127.0.0.1/0", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempFiles = append(tempFiles, path)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected credentials file to be private, got %v", info.Mode().Perm())
+	}
+	Cleanup()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected credentials file to be removed, got %v", err)
+	}
+}
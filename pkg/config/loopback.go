@@ -0,0 +1,67 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// loopbackServer serves credentials on the loopback interface to the clients kops creates from the environment, every
+// registered handler gets its own path and checks the secret it was registered with
+type loopbackServer struct {
+	mutex    sync.Mutex
+	url      string
+	handlers map[string]http.Handler
+}
+
+var loopback loopbackServer
+
+// loopbackSecret generates the secret a handler requires clients to present
+func loopbackSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (s *loopbackServer) start() error {
+	if s.url != "" {
+		return nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	go http.Serve(listener, s)
+	s.url = "http://" + listener.Addr().String()
+	s.handlers = map[string]http.Handler{}
+	return nil
+}
+
+// register serves the given handler and returns its url
+func (s *loopbackServer) register(handler http.Handler) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.start(); err != nil {
+		return "", err
+	}
+	id := strconv.Itoa(len(s.handlers))
+	s.handlers[id] = handler
+	return s.url + "/" + id, nil
+}
+
+func (s *loopbackServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	handler := s.handlers[strings.TrimPrefix(r.URL.Path, "/")]
+	s.mutex.Unlock()
+	if handler == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	handler.ServeHTTP(w, r)
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestLoopbackServer(t *testing.T) {
+	s := &loopbackServer{}
+	register := func(body string) string {
+		url, err := s.register(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, body)
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return url
+	}
+	get := func(url string) (int, string) {
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body)
+	}
+	first := register("first")
+	second := register("second")
+	if first == second {
+		t.Fatalf("expected handlers to be served under their own url, got %s", first)
+	}
+	if code, body := get(first); code != http.StatusOK || body != "first" {
+		t.Errorf("unexpected response %d %q", code, body)
+	}
+	if code, body := get(second); code != http.StatusOK || body != "second" {
+		t.Errorf("unexpected response %d %q", code, body)
+	}
+	if code, _ := get(s.url + "/unknown"); code != http.StatusNotFound {
+		t.Errorf("expected unknown handler to be rejected, got %d", code)
+	}
+}
@@ -20,9 +20,9 @@ func Cluster() *schema.Resource {
 	}
 }
 
-func ClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ClusterRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clusterName := d.Get("name").(string)
-	cluster, err := resources.GetCluster(clusterName, config.Clientset(m))
+	cluster, err := resources.GetCluster(c, clusterName, config.Clientset(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -16,9 +16,9 @@ func ClusterStatus() *schema.Resource {
 	}
 }
 
-func ClusterStatusRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ClusterStatusRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := datasourcesschemas.ExpandDataSourceClusterStatus(d.Get("").(map[string]interface{}))
-	err := in.GetClusterStatus(config.Clientset(m))
+	err := in.GetClusterStatus(c, config.Clientset(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
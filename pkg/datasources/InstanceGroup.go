@@ -3,15 +3,14 @@ package datasources
 import (
 	"context"
 
-	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
 	"github.com/eddycharly/terraform-provider-kops/pkg/config"
-	resourceschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
+	datasourcesschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/datasources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func InstanceGroup() *schema.Resource {
-	res := resourceschemas.DataSourceInstanceGroup()
+	res := datasourcesschemas.DataSourceInstanceGroup()
 	return &schema.Resource{
 		ReadContext:    InstanceGroupRead,
 		Schema:         res.Schema,
@@ -20,14 +19,13 @@ func InstanceGroup() *schema.Resource {
 	}
 }
 
-func InstanceGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	clusterName := d.Get("cluster_name").(string)
-	name := d.Get("name").(string)
-	instanceGroup, err := resources.GetInstanceGroup(clusterName, name, config.Clientset(m))
+func InstanceGroupRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := datasourcesschemas.ExpandDataSourceInstanceGroup(d.Get("").(map[string]interface{}))
+	err := in.GetInstanceGroup(c, config.Clientset(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	for k, v := range resourceschemas.FlattenDataSourceInstanceGroup(*instanceGroup) {
+	for k, v := range datasourcesschemas.FlattenDataSourceInstanceGroup(in) {
 		if err := d.Set(k, v); err != nil {
 			return diag.FromErr(err)
 		}
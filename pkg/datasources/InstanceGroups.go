@@ -0,0 +1,32 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/config"
+	datasourcesschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/datasources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func InstanceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: InstanceGroupsRead,
+		Schema:      datasourcesschemas.DataSourceInstanceGroups().Schema,
+	}
+}
+
+func InstanceGroupsRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := datasourcesschemas.ExpandDataSourceInstanceGroups(d.Get("").(map[string]interface{}))
+	err := in.GetInstanceGroups(c, config.Clientset(m))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range datasourcesschemas.FlattenDataSourceInstanceGroups(in) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("-")
+	return nil
+}
@@ -0,0 +1,32 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/config"
+	datasourcesschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/datasources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Instances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: InstancesRead,
+		Schema:      datasourcesschemas.DataSourceInstances().Schema,
+	}
+}
+
+func InstancesRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := datasourcesschemas.ExpandDataSourceInstances(d.Get("").(map[string]interface{}))
+	err := in.GetInstances(c, config.Clientset(m))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range datasourcesschemas.FlattenDataSourceInstances(in) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("-")
+	return nil
+}
@@ -16,9 +16,9 @@ func KubeConfig() *schema.Resource {
 	}
 }
 
-func KubeConfigRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func KubeConfigRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := datasourcesschemas.ExpandDataSourceKubeConfig(d.Get("").(map[string]interface{}))
-	err := in.GetKubeConfig(config.Clientset(m))
+	err := in.GetKubeConfig(c, config.Clientset(m))
 	if err != nil {
 		return diag.FromErr(err)
 	}
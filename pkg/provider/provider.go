@@ -12,15 +12,22 @@ func NewProvider() *schema.Provider {
 	return &schema.Provider{
 		Schema: configschemas.ConfigProvider().Schema,
 		DataSourcesMap: map[string]*schema.Resource{
-			"kops_cluster":        datasources.Cluster(),
-			"kops_cluster_status": datasources.ClusterStatus(),
-			"kops_instance_group": datasources.InstanceGroup(),
-			"kops_kube_config":    datasources.KubeConfig(),
+			"kops_cluster":               datasources.Cluster(),
+			"kops_cluster_changes":       datasources.ClusterChanges(),
+			"kops_cluster_status":        datasources.ClusterStatus(),
+			"kops_clusters":              datasources.Clusters(),
+			"kops_instance_group":        datasources.InstanceGroup(),
+			"kops_instance_groups":       datasources.InstanceGroups(),
+			"kops_instances":             datasources.Instances(),
+			"kops_kube_config":           datasources.KubeConfig(),
+			"kops_rolling_update_status": datasources.RollingUpdateStatus(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"kops_cluster":         resources.Cluster(),
 			"kops_cluster_updater": resources.ClusterUpdater(),
 			"kops_instance_group":  resources.InstanceGroup(),
+			"kops_keypair":         resources.Keypair(),
+			"kops_secret":          resources.Secret(),
 		},
 		ConfigureContextFunc: config.ConfigureProvider,
 	}
@@ -2,13 +2,16 @@ package resources
 
 import (
 	"context"
+	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
 	"github.com/eddycharly/terraform-provider-kops/pkg/config"
 	"github.com/eddycharly/terraform-provider-kops/pkg/schemas"
 	resourcesschema "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 func Cluster() *schema.Resource {
@@ -18,19 +21,38 @@ func Cluster() *schema.Resource {
 		ReadContext:    ClusterRead,
 		UpdateContext:  ClusterUpdate,
 		DeleteContext:  ClusterDelete,
-		CustomizeDiff:  schemas.CustomizeDiffRevision,
+		CustomizeDiff:  customdiff.Sequence(customizeDiffAdminSshKeys, schemas.CustomizeDiffRevision),
 		Schema:         res.Schema,
 		SchemaVersion:  res.SchemaVersion,
 		StateUpgraders: res.StateUpgraders,
 		Importer: &schema.ResourceImporter{
 			StateContext: ClusterImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
+// customizeDiffAdminSshKeys reports at plan time admin ssh keys kops would refuse to apply
+func customizeDiffAdminSshKeys(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("cloud_provider") || !d.NewValueKnown("admin_ssh_key") || !d.NewValueKnown("admin_ssh_keys") {
+		return nil
+	}
+	var adminSshKeys []string
+	for _, key := range d.Get("admin_ssh_keys").([]interface{}) {
+		if key, ok := key.(string); ok {
+			adminSshKeys = append(adminSshKeys, key)
+		}
+	}
+	return resources.CheckAdminSshKeys(d.Get("cloud_provider").(string), d.Get("admin_ssh_key").(string), adminSshKeys)
+}
+
 func ClusterCreate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceCluster(d.Get("").(map[string]interface{}))
-	if cluster, err := resources.CreateCluster(in.Name, in.AdminSshKey, in.Secrets, in.ClusterSpec, config.Clientset(m)); err != nil {
+	if cluster, err := resources.CreateCluster(c, in.Name, in.AdminSshKey, in.AdminSshKeys, in.Secrets, in.ClusterSpec, in.DeletionProtection, config.Clientset(m)); err != nil {
 		return diag.FromErr(err)
 	} else {
 		d.SetId(cluster.Name)
@@ -40,7 +62,7 @@ func ClusterCreate(c context.Context, d *schema.ResourceData, m interface{}) dia
 
 func ClusterUpdate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceCluster(d.Get("").(map[string]interface{}))
-	if cluster, err := resources.UpdateCluster(in.Name, in.AdminSshKey, in.Secrets, in.ClusterSpec, config.Clientset(m)); err != nil {
+	if cluster, err := resources.UpdateCluster(c, in.Name, in.AdminSshKey, in.AdminSshKeys, in.Secrets, in.ClusterSpec, in.DeletionProtection, config.Clientset(m)); err != nil {
 		return diag.FromErr(err)
 	} else {
 		d.SetId(cluster.Name)
@@ -48,14 +70,20 @@ func ClusterUpdate(c context.Context, d *schema.ResourceData, m interface{}) dia
 	}
 }
 
-func ClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ClusterRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceCluster(d.Get("").(map[string]interface{}))
-	if cluster, err := resources.GetCluster(in.Name, config.Clientset(m)); err != nil {
+	if cluster, err := resources.GetCluster(c, in.Name, config.Clientset(m)); err != nil {
+		// the resource was deleted outside of terraform, remove it from the state so that it gets recreated
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	} else {
+		resources.ArrangeAdminSshKeys(cluster, in.AdminSshKey, in.AdminSshKeys)
 		flattened := resourcesschema.FlattenResourceCluster(*cluster)
 		for key, value := range flattened {
-			if key != "revision" {
+			if key != "revision" && key != "delete_mode" {
 				if err := d.Set(key, value); err != nil {
 					return diag.FromErr(err)
 				}
@@ -65,21 +93,21 @@ func ClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.
 	return nil
 }
 
-func ClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ClusterDelete(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceCluster(d.Get("").(map[string]interface{}))
-	if err := resources.DeleteCluster(in.Name, config.Clientset(m)); err != nil {
+	if err := resources.DeleteCluster(c, in.Name, in.DeleteMode, config.Clientset(m)); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func ClusterImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	if cluster, err := resources.GetCluster(d.Id(), config.Clientset(m)); err != nil {
+func ClusterImport(c context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if cluster, err := resources.GetCluster(c, d.Id(), config.Clientset(m)); err != nil {
 		return []*schema.ResourceData{}, err
 	} else {
 		flattened := resourcesschema.FlattenResourceCluster(*cluster)
 		for key, value := range flattened {
-			if key != "revision" {
+			if key != "revision" && key != "delete_mode" {
 				if err := d.Set(key, value); err != nil {
 					return []*schema.ResourceData{}, err
 				}
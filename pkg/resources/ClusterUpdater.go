@@ -2,34 +2,82 @@ package resources
 
 import (
 	"context"
+	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/config"
 	"github.com/eddycharly/terraform-provider-kops/pkg/schemas"
 	resourcesschema "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 func ClusterUpdater() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: ClusterUpdaterCreateOrUpdate,
-		ReadContext:          schema.NoopContext,
-		UpdateWithoutTimeout: ClusterUpdaterCreateOrUpdate,
-		DeleteWithoutTimeout: ClusterUpdaterDelete,
-		CustomizeDiff:        schemas.CustomizeDiffRevision,
-		Schema:               resourcesschema.ResourceClusterUpdater().Schema,
+		CreateContext: ClusterUpdaterCreateOrUpdate,
+		ReadContext:   ClusterUpdaterRead,
+		UpdateContext: ClusterUpdaterCreateOrUpdate,
+		DeleteContext: ClusterUpdaterDelete,
+		CustomizeDiff: customdiff.Sequence(customizeDiffDrift, schemas.CustomizeDiffRevision),
+		Schema:        resourcesschema.ResourceClusterUpdater().Schema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
-func ClusterUpdaterCreateOrUpdate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ClusterUpdaterCreateOrUpdate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceClusterUpdater(d.Get("").(map[string]interface{}))
-	if err := in.UpdateCluster(config.Clientset(m)); err != nil {
+	if err := in.UpdateCluster(c, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("needs_apply", false); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("needs_rolling_update", false); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(in.ClusterName)
 	return nil
 }
 
+func ClusterUpdaterRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceClusterUpdater(d.Get("").(map[string]interface{}))
+	if err := in.CheckDrift(c, config.Clientset(m)); err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err := d.Set("needs_apply", in.NeedsApply); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("needs_rolling_update", in.NeedsRollingUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// customizeDiffDrift plans an update when the last read found the cluster out of date
+func customizeDiffDrift(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.Get("needs_apply").(bool) || d.Get("needs_rolling_update").(bool) {
+		if err := d.SetNew("needs_apply", false); err != nil {
+			return err
+		}
+		if err := d.SetNew("needs_rolling_update", false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ClusterUpdaterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return diag.FromErr(schema.RemoveFromState(d, m))
 }
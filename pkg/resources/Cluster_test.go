@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestClusterAdminSshKeysDiff(t *testing.T) {
+	for _, tc := range []struct {
+		cloudProvider string
+		adminSshKeys  []interface{}
+		wantErr       bool
+	}{
+		{"aws", []interface{}{"ssh-rsa key-0"}, false},
+		{"aws", nil, false},
+		{"aws", []interface{}{"ssh-rsa key-1", "ssh-rsa key-2"}, true},
+		{"aws", []interface{}{"ssh-rsa key-0", "ssh-rsa key-1"}, true},
+		{"azure", []interface{}{"ssh-rsa key-1", "ssh-rsa key-2"}, true},
+		{"alicloud", []interface{}{"ssh-rsa key-1", "ssh-rsa key-2"}, true},
+		{"openstack", []interface{}{"ssh-rsa key-1", "ssh-rsa key-2"}, true},
+		{"gce", []interface{}{"ssh-rsa key-1", "ssh-rsa key-2"}, false},
+	} {
+		config := map[string]interface{}{
+			"name":           "cluster.example.com",
+			"cloud_provider": tc.cloudProvider,
+			"admin_ssh_key":  "ssh-rsa key-0",
+			"admin_ssh_keys": tc.adminSshKeys,
+		}
+		_, err := Cluster().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		if tc.wantErr && err == nil {
+			t.Errorf("%s %v: expected an error", tc.cloudProvider, tc.adminSshKeys)
+		} else if !tc.wantErr && err != nil {
+			t.Errorf("%s %v: unexpected error %v", tc.cloudProvider, tc.adminSshKeys, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeleteModeValidation(t *testing.T) {
+	for name, res := range map[string]*schema.Resource{
+		"kops_cluster":        Cluster(),
+		"kops_instance_group": InstanceGroup(),
+	} {
+		validate := res.Schema["delete_mode"].ValidateFunc
+		if validate == nil {
+			t.Fatalf("%s: delete_mode has no validation", name)
+		}
+		for _, value := range []string{"destroy", "state_store_only", "orphan"} {
+			if _, errs := validate(value, "delete_mode"); len(errs) != 0 {
+				t.Errorf("%s: unexpected errors for %q: %v", name, value, errs)
+			}
+		}
+		for _, value := range []string{"destory", "Destroy", "delete"} {
+			if _, errs := validate(value, "delete_mode"); len(errs) == 0 {
+				t.Errorf("%s: expected an error for %q", name, value)
+			}
+		}
+	}
+}
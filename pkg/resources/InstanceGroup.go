@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
@@ -12,6 +13,7 @@ import (
 	resourcesschema "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 func InstanceGroup() *schema.Resource {
@@ -26,12 +28,17 @@ func InstanceGroup() *schema.Resource {
 		Schema:         res.Schema,
 		SchemaVersion:  res.SchemaVersion,
 		StateUpgraders: res.StateUpgraders,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 func InstanceGroupCreate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceInstanceGroup(d.Get("").(map[string]interface{}))
-	if instanceGroup, err := resources.CreateInstanceGroup(in.ClusterName, in.Name, in.InstanceGroupSpec, config.Clientset(m)); err != nil {
+	if instanceGroup, err := resources.CreateInstanceGroup(c, in.ClusterName, in.Name, in.InstanceGroupSpec, in.DeletionProtection, config.Clientset(m)); err != nil {
 		return diag.FromErr(err)
 	} else {
 		d.SetId(fmt.Sprintf("%s/%s", instanceGroup.ClusterName, instanceGroup.Name))
@@ -41,7 +48,7 @@ func InstanceGroupCreate(c context.Context, d *schema.ResourceData, m interface{
 
 func InstanceGroupUpdate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceInstanceGroup(d.Get("").(map[string]interface{}))
-	if instanceGroup, err := resources.UpdateInstanceGroup(in.ClusterName, in.Name, in.InstanceGroupSpec, config.Clientset(m)); err != nil {
+	if instanceGroup, err := resources.UpdateInstanceGroup(c, in.ClusterName, in.Name, in.InstanceGroupSpec, in.DeletionProtection, config.Clientset(m)); err != nil {
 		return diag.FromErr(err)
 	} else {
 		d.SetId(fmt.Sprintf("%s/%s", instanceGroup.ClusterName, instanceGroup.Name))
@@ -49,14 +56,18 @@ func InstanceGroupUpdate(c context.Context, d *schema.ResourceData, m interface{
 	}
 }
 
-func InstanceGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func InstanceGroupRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceInstanceGroup(d.Get("").(map[string]interface{}))
-	if instanceGroup, err := resources.GetInstanceGroup(in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+	if instanceGroup, err := resources.GetInstanceGroup(c, in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	} else {
 		flattened := resourcesschema.FlattenResourceInstanceGroup(*instanceGroup)
 		for key, value := range flattened {
-			if key != "revision" {
+			if key != "revision" && key != "delete_mode" && key != "delete" {
 				if err := d.Set(key, value); err != nil {
 					return diag.FromErr(err)
 				}
@@ -66,25 +77,27 @@ func InstanceGroupRead(_ context.Context, d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func InstanceGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func InstanceGroupDelete(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	in := resourcesschema.ExpandResourceInstanceGroup(d.Get("").(map[string]interface{}))
-	if err := utils.InstanceGroupDelete(config.Clientset(m), in.ClusterName, in.Name); err != nil {
+	if err := utils.InstanceGroupDelete(c, config.Clientset(m), in.ClusterName, in.Name, in.DeleteMode, in.Delete); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func InstanceGroupImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func InstanceGroupImport(c context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	if parts := strings.Split(d.Id(), "/"); len(parts) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Unexpected id format: %s. Please use 'cluster name/instance group name' format.", d.Id())
 	} else {
-		if instanceGroup, err := resources.GetInstanceGroup(parts[0], parts[1], config.Clientset(m)); err != nil {
+		if instanceGroup, err := resources.GetInstanceGroup(c, parts[0], parts[1], config.Clientset(m)); err != nil {
 			return []*schema.ResourceData{}, err
 		} else {
 			flattened := resourcesschema.FlattenResourceInstanceGroup(*instanceGroup)
 			for key, value := range flattened {
-				if err := d.Set(key, value); err != nil {
-					return []*schema.ResourceData{}, err
+				if key != "delete_mode" && key != "delete" {
+					if err := d.Set(key, value); err != nil {
+						return []*schema.ResourceData{}, err
+					}
 				}
 			}
 			d.SetId(fmt.Sprintf("%s/%s", instanceGroup.ClusterName, instanceGroup.Name))
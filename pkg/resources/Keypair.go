@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/config"
+	"github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	resourcesschema "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func Keypair() *schema.Resource {
+	res := resourcesschema.ResourceKeypair()
+	return &schema.Resource{
+		CreateContext: KeypairCreate,
+		ReadContext:   KeypairRead,
+		UpdateContext: KeypairUpdate,
+		DeleteContext: KeypairDelete,
+		CustomizeDiff: schemas.CustomizeDiffRevision,
+		Importer:      &schema.ResourceImporter{StateContext: KeypairImport},
+		Schema:        res.Schema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func KeypairCreate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceKeypair(d.Get("").(map[string]interface{}))
+	if keypair, err := resources.CreateKeypair(c, in.ClusterName, in.Name, in.Cert, in.Key, in.StagedCert, in.Distrust, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", keypair.ClusterName, keypair.Name))
+		return KeypairRead(c, d, m)
+	}
+}
+
+func KeypairUpdate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceKeypair(d.Get("").(map[string]interface{}))
+	if keypair, err := resources.UpdateKeypair(c, in.ClusterName, in.Name, in.Cert, in.Key, in.StagedCert, in.Distrust, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", keypair.ClusterName, keypair.Name))
+		return KeypairRead(c, d, m)
+	}
+}
+
+func KeypairRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceKeypair(d.Get("").(map[string]interface{}))
+	if keypair, err := resources.GetKeypair(c, in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	} else {
+		flattened := resourcesschema.FlattenResourceKeypair(*keypair)
+		for key, value := range flattened {
+			if key != "revision" && key != "staged_cert" && key != "distrust" {
+				if err := d.Set(key, value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func KeypairDelete(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceKeypair(d.Get("").(map[string]interface{}))
+	if err := resources.DeleteKeypair(c, in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func KeypairImport(c context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.Split(d.Id(), "/"); len(parts) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Unexpected id format: %s. Please use 'cluster name/keyset name' format.", d.Id())
+	} else {
+		if keypair, err := resources.GetKeypair(c, parts[0], parts[1], config.Clientset(m)); err != nil {
+			return []*schema.ResourceData{}, err
+		} else {
+			flattened := resourcesschema.FlattenResourceKeypair(*keypair)
+			for key, value := range flattened {
+				if err := d.Set(key, value); err != nil {
+					return []*schema.ResourceData{}, err
+				}
+			}
+			d.SetId(fmt.Sprintf("%s/%s", keypair.ClusterName, keypair.Name))
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
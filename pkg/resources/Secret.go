@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/config"
+	"github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	resourcesschema "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func Secret() *schema.Resource {
+	res := resourcesschema.ResourceSecret()
+	return &schema.Resource{
+		CreateContext: SecretCreate,
+		ReadContext:   SecretRead,
+		UpdateContext: SecretUpdate,
+		DeleteContext: SecretDelete,
+		CustomizeDiff: schemas.CustomizeDiffRevision,
+		Importer:      &schema.ResourceImporter{StateContext: SecretImport},
+		Schema:        res.Schema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// flattenSecret only keeps the attribute holding the secret content in the format used in the configuration
+func flattenSecret(secret resources.Secret, base64 bool) map[string]interface{} {
+	flattened := resourcesschema.FlattenResourceSecret(secret)
+	delete(flattened, "revision")
+	if base64 {
+		delete(flattened, "data")
+	} else {
+		delete(flattened, "data_base64")
+	}
+	return flattened
+}
+
+func SecretCreate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceSecret(d.Get("").(map[string]interface{}))
+	if secret, err := resources.CreateSecret(c, in.ClusterName, in.Name, in.Data, in.DataBase64, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", secret.ClusterName, secret.Name))
+		return SecretRead(c, d, m)
+	}
+}
+
+func SecretUpdate(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceSecret(d.Get("").(map[string]interface{}))
+	if secret, err := resources.UpdateSecret(c, in.ClusterName, in.Name, in.Data, in.DataBase64, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", secret.ClusterName, secret.Name))
+		return SecretRead(c, d, m)
+	}
+}
+
+func SecretRead(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceSecret(d.Get("").(map[string]interface{}))
+	if secret, err := resources.GetSecret(c, in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	} else {
+		for key, value := range flattenSecret(*secret, in.DataBase64 != "") {
+			if err := d.Set(key, value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	return nil
+}
+
+func SecretDelete(c context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	in := resourcesschema.ExpandResourceSecret(d.Get("").(map[string]interface{}))
+	if err := resources.DeleteSecret(c, in.ClusterName, in.Name, config.Clientset(m)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func SecretImport(c context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.Split(d.Id(), "/"); len(parts) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Unexpected id format: %s. Please use 'cluster name/secret name' format.", d.Id())
+	} else {
+		if secret, err := resources.GetSecret(c, parts[0], parts[1], config.Clientset(m)); err != nil {
+			return []*schema.ResourceData{}, err
+		} else {
+			// binary payloads can only be represented base64 encoded
+			for key, value := range flattenSecret(*secret, secret.Data == "" && secret.DataBase64 != "") {
+				if err := d.Set(key, value); err != nil {
+					return []*schema.ResourceData{}, err
+				}
+			}
+			d.SetId(fmt.Sprintf("%s/%s", secret.ClusterName, secret.Name))
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
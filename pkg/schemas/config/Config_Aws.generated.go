@@ -21,6 +21,7 @@ func ConfigAws() *schema.Resource {
 			"s3_access_key":     OptionalString(),
 			"s3_secret_key":     OptionalString(),
 			"skip_region_check": OptionalBool(),
+			"endpoints":         OptionalStruct(ConfigAwsEndpoints()),
 		},
 	}
 
@@ -77,6 +78,24 @@ func ExpandConfigAws(in map[string]interface{}) config.Aws {
 		SkipRegionCheck: func(in interface{}) bool {
 			return bool(ExpandBool(in))
 		}(in["skip_region_check"]),
+		Endpoints: func(in interface{}) *config.AwsEndpoints {
+			return func(in interface{}) *config.AwsEndpoints {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in config.AwsEndpoints) *config.AwsEndpoints {
+					return &in
+				}(func(in interface{}) config.AwsEndpoints {
+					if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+						return config.AwsEndpoints{}
+					}
+					return (ExpandConfigAwsEndpoints(in.([]interface{})[0].(map[string]interface{})))
+				}(in))
+			}(in)
+		}(in["endpoints"]),
 	}
 }
 
@@ -120,6 +139,18 @@ func FlattenConfigAwsInto(in config.Aws, out map[string]interface{}) {
 	out["skip_region_check"] = func(in bool) interface{} {
 		return FlattenBool(bool(in))
 	}(in.SkipRegionCheck)
+	out["endpoints"] = func(in *config.AwsEndpoints) interface{} {
+		return func(in *config.AwsEndpoints) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in config.AwsEndpoints) interface{} {
+				return func(in config.AwsEndpoints) []interface{} {
+					return []interface{}{FlattenConfigAwsEndpoints(in)}
+				}(in)
+			}(*in)
+		}(in)
+	}(in.Endpoints)
 }
 
 func FlattenConfigAws(in config.Aws) map[string]interface{} {
@@ -31,6 +31,7 @@ func TestExpandConfigAws(t *testing.T) {
 					"s3_access_key":     "",
 					"s3_secret_key":     "",
 					"skip_region_check": false,
+					"endpoints":         nil,
 				},
 			},
 			want: _default,
@@ -58,6 +59,7 @@ func TestFlattenConfigAwsInto(t *testing.T) {
 		"s3_access_key":     "",
 		"s3_secret_key":     "",
 		"skip_region_check": false,
+		"endpoints":         nil,
 	}
 	type args struct {
 		in config.Aws
@@ -184,6 +186,17 @@ func TestFlattenConfigAwsInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "Endpoints - default",
+			args: args{
+				in: func() config.Aws {
+					subject := config.Aws{}
+					subject.Endpoints = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -208,6 +221,7 @@ func TestFlattenConfigAws(t *testing.T) {
 		"s3_access_key":     "",
 		"s3_secret_key":     "",
 		"skip_region_check": false,
+		"endpoints":         nil,
 	}
 	type args struct {
 		in config.Aws
@@ -334,6 +348,17 @@ func TestFlattenConfigAws(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "Endpoints - default",
+			args: args{
+				in: func() config.Aws {
+					subject := config.Aws{}
+					subject.Endpoints = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
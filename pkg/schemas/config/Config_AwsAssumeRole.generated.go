@@ -1,9 +1,12 @@
 package schemas
 
 import (
+	"reflect"
+
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
 	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Schema
@@ -11,7 +14,16 @@ var _ = Schema
 func ConfigAwsAssumeRole() *schema.Resource {
 	res := &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			"role_arn": OptionalString(),
+			"role_arn":                OptionalString(),
+			"external_id":             OptionalString(),
+			"session_name":            OptionalString(),
+			"duration":                OptionalDuration(),
+			"policy":                  OptionalString(),
+			"policy_arns":             OptionalList(String()),
+			"tags":                    OptionalMap(String()),
+			"source_identity":         OptionalString(),
+			"web_identity_token":      Sensitive(OptionalString()),
+			"web_identity_token_file": OptionalString(),
 		},
 	}
 
@@ -26,6 +38,72 @@ func ExpandConfigAwsAssumeRole(in map[string]interface{}) config.AwsAssumeRole {
 		RoleArn: func(in interface{}) string {
 			return string(ExpandString(in))
 		}(in["role_arn"]),
+		ExternalId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["external_id"]),
+		SessionName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["session_name"]),
+		Duration: func(in interface{}) *v1.Duration {
+			if in == nil {
+				return nil
+			}
+			if reflect.DeepEqual(in, reflect.Zero(reflect.TypeOf(in)).Interface()) {
+				return nil
+			}
+			return func(in interface{}) *v1.Duration {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in v1.Duration) *v1.Duration {
+					return &in
+				}(ExpandDuration(in))
+			}(in)
+		}(in["duration"]),
+		Policy: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["policy"]),
+		PolicyArns: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["policy_arns"]),
+		Tags: func(in interface{}) map[string]string {
+			return func(in interface{}) map[string]string {
+				if in == nil {
+					return nil
+				}
+				if in, ok := in.(map[string]interface{}); ok {
+					if len(in) > 0 {
+						out := map[string]string{}
+						for key, in := range in {
+							out[key] = string(ExpandString(in))
+						}
+						return out
+					}
+				}
+				return nil
+			}(in)
+		}(in["tags"]),
+		SourceIdentity: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["source_identity"]),
+		WebIdentityToken: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["web_identity_token"]),
+		WebIdentityTokenFile: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["web_identity_token_file"]),
 	}
 }
 
@@ -33,6 +111,55 @@ func FlattenConfigAwsAssumeRoleInto(in config.AwsAssumeRole, out map[string]inte
 	out["role_arn"] = func(in string) interface{} {
 		return FlattenString(string(in))
 	}(in.RoleArn)
+	out["external_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ExternalId)
+	out["session_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SessionName)
+	out["duration"] = func(in *v1.Duration) interface{} {
+		return func(in *v1.Duration) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in v1.Duration) interface{} {
+				return FlattenDuration(in)
+			}(*in)
+		}(in)
+	}(in.Duration)
+	out["policy"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Policy)
+	out["policy_arns"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.PolicyArns)
+	out["tags"] = func(in map[string]string) interface{} {
+		return func(in map[string]string) map[string]interface{} {
+			if in == nil {
+				return nil
+			}
+			out := map[string]interface{}{}
+			for key, in := range in {
+				out[key] = FlattenString(string(in))
+			}
+			return out
+		}(in)
+	}(in.Tags)
+	out["source_identity"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SourceIdentity)
+	out["web_identity_token"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.WebIdentityToken)
+	out["web_identity_token_file"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.WebIdentityTokenFile)
 }
 
 func FlattenConfigAwsAssumeRole(in config.AwsAssumeRole) map[string]interface{} {
@@ -21,7 +21,16 @@ func TestExpandConfigAwsAssumeRole(t *testing.T) {
 			name: "default",
 			args: args{
 				in: map[string]interface{}{
-					"role_arn": "",
+					"role_arn":                "",
+					"external_id":             "",
+					"session_name":            "",
+					"duration":                nil,
+					"policy":                  "",
+					"policy_arns":             func() []interface{} { return nil }(),
+					"tags":                    func() map[string]interface{} { return nil }(),
+					"source_identity":         "",
+					"web_identity_token":      "",
+					"web_identity_token_file": "",
 				},
 			},
 			want: _default,
@@ -39,7 +48,16 @@ func TestExpandConfigAwsAssumeRole(t *testing.T) {
 
 func TestFlattenConfigAwsAssumeRoleInto(t *testing.T) {
 	_default := map[string]interface{}{
-		"role_arn": "",
+		"role_arn":                "",
+		"external_id":             "",
+		"session_name":            "",
+		"duration":                nil,
+		"policy":                  "",
+		"policy_arns":             func() []interface{} { return nil }(),
+		"tags":                    func() map[string]interface{} { return nil }(),
+		"source_identity":         "",
+		"web_identity_token":      "",
+		"web_identity_token_file": "",
 	}
 	type args struct {
 		in config.AwsAssumeRole
@@ -67,6 +85,105 @@ func TestFlattenConfigAwsAssumeRoleInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "ExternalId - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.ExternalId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SessionName - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.SessionName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Duration - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Duration = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Policy - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Policy = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PolicyArns - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.PolicyArns = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Tags - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Tags = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SourceIdentity - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.SourceIdentity = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "WebIdentityToken - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.WebIdentityToken = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "WebIdentityTokenFile - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.WebIdentityTokenFile = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -81,7 +198,16 @@ func TestFlattenConfigAwsAssumeRoleInto(t *testing.T) {
 
 func TestFlattenConfigAwsAssumeRole(t *testing.T) {
 	_default := map[string]interface{}{
-		"role_arn": "",
+		"role_arn":                "",
+		"external_id":             "",
+		"session_name":            "",
+		"duration":                nil,
+		"policy":                  "",
+		"policy_arns":             func() []interface{} { return nil }(),
+		"tags":                    func() map[string]interface{} { return nil }(),
+		"source_identity":         "",
+		"web_identity_token":      "",
+		"web_identity_token_file": "",
 	}
 	type args struct {
 		in config.AwsAssumeRole
@@ -109,6 +235,105 @@ func TestFlattenConfigAwsAssumeRole(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "ExternalId - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.ExternalId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SessionName - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.SessionName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Duration - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Duration = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Policy - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Policy = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PolicyArns - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.PolicyArns = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Tags - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.Tags = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SourceIdentity - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.SourceIdentity = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "WebIdentityToken - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.WebIdentityToken = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "WebIdentityTokenFile - default",
+			args: args{
+				in: func() config.AwsAssumeRole {
+					subject := config.AwsAssumeRole{}
+					subject.WebIdentityTokenFile = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,91 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ConfigAwsEndpoints() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ec2":         OptionalString(),
+			"iam":         OptionalString(),
+			"elb":         OptionalString(),
+			"elbv2":       OptionalString(),
+			"route53":     OptionalString(),
+			"autoscaling": OptionalString(),
+			"sts":         OptionalString(),
+			"sqs":         OptionalString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandConfigAwsEndpoints(in map[string]interface{}) config.AwsEndpoints {
+	if in == nil {
+		panic("expand AwsEndpoints failure, in is nil")
+	}
+	return config.AwsEndpoints{
+		Ec2: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["ec2"]),
+		Iam: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["iam"]),
+		Elb: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["elb"]),
+		Elbv2: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["elbv2"]),
+		Route53: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["route53"]),
+		Autoscaling: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["autoscaling"]),
+		Sts: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["sts"]),
+		Sqs: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["sqs"]),
+	}
+}
+
+func FlattenConfigAwsEndpointsInto(in config.AwsEndpoints, out map[string]interface{}) {
+	out["ec2"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Ec2)
+	out["iam"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Iam)
+	out["elb"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Elb)
+	out["elbv2"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Elbv2)
+	out["route53"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Route53)
+	out["autoscaling"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Autoscaling)
+	out["sts"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Sts)
+	out["sqs"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Sqs)
+}
+
+func FlattenConfigAwsEndpoints(in config.AwsEndpoints) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenConfigAwsEndpointsInto(in, out)
+	return out
+}
@@ -0,0 +1,296 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandConfigAwsEndpoints(t *testing.T) {
+	_default := config.AwsEndpoints{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want config.AwsEndpoints
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"ec2":         "",
+					"iam":         "",
+					"elb":         "",
+					"elbv2":       "",
+					"route53":     "",
+					"autoscaling": "",
+					"sts":         "",
+					"sqs":         "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandConfigAwsEndpoints(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandConfigAwsEndpoints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigAwsEndpointsInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"ec2":         "",
+		"iam":         "",
+		"elb":         "",
+		"elbv2":       "",
+		"route53":     "",
+		"autoscaling": "",
+		"sts":         "",
+		"sqs":         "",
+	}
+	type args struct {
+		in config.AwsEndpoints
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.AwsEndpoints{},
+			},
+			want: _default,
+		},
+		{
+			name: "Ec2 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Ec2 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Iam - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Iam = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Elb - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Elb = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Elbv2 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Elbv2 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Route53 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Route53 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Autoscaling - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Autoscaling = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Sts - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Sts = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Sqs - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Sqs = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenConfigAwsEndpointsInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigAwsEndpoints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigAwsEndpoints(t *testing.T) {
+	_default := map[string]interface{}{
+		"ec2":         "",
+		"iam":         "",
+		"elb":         "",
+		"elbv2":       "",
+		"route53":     "",
+		"autoscaling": "",
+		"sts":         "",
+		"sqs":         "",
+	}
+	type args struct {
+		in config.AwsEndpoints
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.AwsEndpoints{},
+			},
+			want: _default,
+		},
+		{
+			name: "Ec2 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Ec2 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Iam - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Iam = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Elb - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Elb = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Elbv2 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Elbv2 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Route53 - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Route53 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Autoscaling - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Autoscaling = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Sts - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Sts = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Sqs - default",
+			args: args{
+				in: func() config.AwsEndpoints {
+					subject := config.AwsEndpoints{}
+					subject.Sqs = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenConfigAwsEndpoints(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigAwsEndpoints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
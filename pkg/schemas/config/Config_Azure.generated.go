@@ -0,0 +1,77 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ConfigAzure() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tenant_id":       OptionalString(),
+			"subscription_id": OptionalString(),
+			"client_id":       OptionalString(),
+			"client_secret":   Sensitive(OptionalString()),
+			"storage_account": OptionalString(),
+			"storage_key":     Sensitive(OptionalString()),
+		},
+	}
+
+	return res
+}
+
+func ExpandConfigAzure(in map[string]interface{}) config.Azure {
+	if in == nil {
+		panic("expand Azure failure, in is nil")
+	}
+	return config.Azure{
+		TenantId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["tenant_id"]),
+		SubscriptionId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["subscription_id"]),
+		ClientId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["client_id"]),
+		ClientSecret: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["client_secret"]),
+		StorageAccount: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["storage_account"]),
+		StorageKey: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["storage_key"]),
+	}
+}
+
+func FlattenConfigAzureInto(in config.Azure, out map[string]interface{}) {
+	out["tenant_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.TenantId)
+	out["subscription_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SubscriptionId)
+	out["client_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClientId)
+	out["client_secret"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClientSecret)
+	out["storage_account"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.StorageAccount)
+	out["storage_key"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.StorageKey)
+}
+
+func FlattenConfigAzure(in config.Azure) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenConfigAzureInto(in, out)
+	return out
+}
@@ -0,0 +1,246 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandConfigAzure(t *testing.T) {
+	_default := config.Azure{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want config.Azure
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"tenant_id":       "",
+					"subscription_id": "",
+					"client_id":       "",
+					"client_secret":   "",
+					"storage_account": "",
+					"storage_key":     "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandConfigAzure(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandConfigAzure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigAzureInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"tenant_id":       "",
+		"subscription_id": "",
+		"client_id":       "",
+		"client_secret":   "",
+		"storage_account": "",
+		"storage_key":     "",
+	}
+	type args struct {
+		in config.Azure
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.Azure{},
+			},
+			want: _default,
+		},
+		{
+			name: "TenantId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.TenantId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SubscriptionId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.SubscriptionId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClientId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.ClientId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClientSecret - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.ClientSecret = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StorageAccount - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.StorageAccount = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StorageKey - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.StorageKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenConfigAzureInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigAzure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigAzure(t *testing.T) {
+	_default := map[string]interface{}{
+		"tenant_id":       "",
+		"subscription_id": "",
+		"client_id":       "",
+		"client_secret":   "",
+		"storage_account": "",
+		"storage_key":     "",
+	}
+	type args struct {
+		in config.Azure
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.Azure{},
+			},
+			want: _default,
+		},
+		{
+			name: "TenantId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.TenantId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SubscriptionId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.SubscriptionId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClientId - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.ClientId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClientSecret - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.ClientSecret = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StorageAccount - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.StorageAccount = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StorageKey - default",
+			args: args{
+				in: func() config.Azure {
+					subject := config.Azure{}
+					subject.StorageKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenConfigAzure(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigAzure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ConfigDigitalOcean() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"access_token":      Sensitive(OptionalString()),
+			"spaces_endpoint":   OptionalString(),
+			"spaces_access_key": OptionalString(),
+			"spaces_secret_key": Sensitive(OptionalString()),
+		},
+	}
+
+	return res
+}
+
+func ExpandConfigDigitalOcean(in map[string]interface{}) config.DigitalOcean {
+	if in == nil {
+		panic("expand DigitalOcean failure, in is nil")
+	}
+	return config.DigitalOcean{
+		AccessToken: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["access_token"]),
+		SpacesEndpoint: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["spaces_endpoint"]),
+		SpacesAccessKey: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["spaces_access_key"]),
+		SpacesSecretKey: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["spaces_secret_key"]),
+	}
+}
+
+func FlattenConfigDigitalOceanInto(in config.DigitalOcean, out map[string]interface{}) {
+	out["access_token"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.AccessToken)
+	out["spaces_endpoint"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SpacesEndpoint)
+	out["spaces_access_key"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SpacesAccessKey)
+	out["spaces_secret_key"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.SpacesSecretKey)
+}
+
+func FlattenConfigDigitalOcean(in config.DigitalOcean) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenConfigDigitalOceanInto(in, out)
+	return out
+}
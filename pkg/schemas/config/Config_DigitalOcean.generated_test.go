@@ -0,0 +1,196 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandConfigDigitalOcean(t *testing.T) {
+	_default := config.DigitalOcean{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want config.DigitalOcean
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"access_token":      "",
+					"spaces_endpoint":   "",
+					"spaces_access_key": "",
+					"spaces_secret_key": "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandConfigDigitalOcean(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandConfigDigitalOcean() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigDigitalOceanInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"access_token":      "",
+		"spaces_endpoint":   "",
+		"spaces_access_key": "",
+		"spaces_secret_key": "",
+	}
+	type args struct {
+		in config.DigitalOcean
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.DigitalOcean{},
+			},
+			want: _default,
+		},
+		{
+			name: "AccessToken - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.AccessToken = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesEndpoint - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesEndpoint = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesAccessKey - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesAccessKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesSecretKey - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesSecretKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenConfigDigitalOceanInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigDigitalOcean() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigDigitalOcean(t *testing.T) {
+	_default := map[string]interface{}{
+		"access_token":      "",
+		"spaces_endpoint":   "",
+		"spaces_access_key": "",
+		"spaces_secret_key": "",
+	}
+	type args struct {
+		in config.DigitalOcean
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.DigitalOcean{},
+			},
+			want: _default,
+		},
+		{
+			name: "AccessToken - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.AccessToken = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesEndpoint - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesEndpoint = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesAccessKey - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesAccessKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "SpacesSecretKey - default",
+			args: args{
+				in: func() config.DigitalOcean {
+					subject := config.DigitalOcean{}
+					subject.SpacesSecretKey = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenConfigDigitalOcean(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigDigitalOcean() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ConfigGce() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project":                     OptionalString(),
+			"region":                      OptionalString(),
+			"credentials":                 Sensitive(OptionalString()),
+			"credentials_file":            OptionalString(),
+			"impersonate_service_account": OptionalString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandConfigGce(in map[string]interface{}) config.Gce {
+	if in == nil {
+		panic("expand Gce failure, in is nil")
+	}
+	return config.Gce{
+		Project: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["project"]),
+		Region: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["region"]),
+		Credentials: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["credentials"]),
+		CredentialsFile: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["credentials_file"]),
+		ImpersonateServiceAccount: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["impersonate_service_account"]),
+	}
+}
+
+func FlattenConfigGceInto(in config.Gce, out map[string]interface{}) {
+	out["project"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Project)
+	out["region"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Region)
+	out["credentials"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Credentials)
+	out["credentials_file"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.CredentialsFile)
+	out["impersonate_service_account"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ImpersonateServiceAccount)
+}
+
+func FlattenConfigGce(in config.Gce) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenConfigGceInto(in, out)
+	return out
+}
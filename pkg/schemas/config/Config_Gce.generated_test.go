@@ -0,0 +1,221 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandConfigGce(t *testing.T) {
+	_default := config.Gce{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want config.Gce
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"project":                     "",
+					"region":                      "",
+					"credentials":                 "",
+					"credentials_file":            "",
+					"impersonate_service_account": "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandConfigGce(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandConfigGce() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigGceInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"project":                     "",
+		"region":                      "",
+		"credentials":                 "",
+		"credentials_file":            "",
+		"impersonate_service_account": "",
+	}
+	type args struct {
+		in config.Gce
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.Gce{},
+			},
+			want: _default,
+		},
+		{
+			name: "Project - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Project = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Region - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Region = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Credentials - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Credentials = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CredentialsFile - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.CredentialsFile = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ImpersonateServiceAccount - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.ImpersonateServiceAccount = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenConfigGceInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigGce() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenConfigGce(t *testing.T) {
+	_default := map[string]interface{}{
+		"project":                     "",
+		"region":                      "",
+		"credentials":                 "",
+		"credentials_file":            "",
+		"impersonate_service_account": "",
+	}
+	type args struct {
+		in config.Gce
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: config.Gce{},
+			},
+			want: _default,
+		},
+		{
+			name: "Project - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Project = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Region - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Region = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Credentials - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.Credentials = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CredentialsFile - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.CredentialsFile = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ImpersonateServiceAccount - default",
+			args: args{
+				in: func() config.Gce {
+					subject := config.Gce{}
+					subject.ImpersonateServiceAccount = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenConfigGce(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenConfigGce() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
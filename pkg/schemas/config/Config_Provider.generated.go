@@ -14,6 +14,9 @@ func ConfigProvider() *schema.Resource {
 			"state_store":   RequiredString(),
 			"aws":           OptionalStruct(ConfigAws()),
 			"openstack":     OptionalStruct(ConfigOpenstack()),
+			"gce":           OptionalStruct(ConfigGce()),
+			"digitalocean":  OptionalStruct(ConfigDigitalOcean()),
+			"azure":         OptionalStruct(ConfigAzure()),
 			"klog":          OptionalStruct(ConfigKlog()),
 			"mock":          OptionalBool(),
 			"feature_flags": OptionalList(String()),
@@ -67,6 +70,60 @@ func ExpandConfigProvider(in map[string]interface{}) config.Provider {
 				}(in))
 			}(in)
 		}(in["openstack"]),
+		Gce: func(in interface{}) *config.Gce {
+			return func(in interface{}) *config.Gce {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in config.Gce) *config.Gce {
+					return &in
+				}(func(in interface{}) config.Gce {
+					if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+						return config.Gce{}
+					}
+					return (ExpandConfigGce(in.([]interface{})[0].(map[string]interface{})))
+				}(in))
+			}(in)
+		}(in["gce"]),
+		DigitalOcean: func(in interface{}) *config.DigitalOcean {
+			return func(in interface{}) *config.DigitalOcean {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in config.DigitalOcean) *config.DigitalOcean {
+					return &in
+				}(func(in interface{}) config.DigitalOcean {
+					if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+						return config.DigitalOcean{}
+					}
+					return (ExpandConfigDigitalOcean(in.([]interface{})[0].(map[string]interface{})))
+				}(in))
+			}(in)
+		}(in["digitalocean"]),
+		Azure: func(in interface{}) *config.Azure {
+			return func(in interface{}) *config.Azure {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in config.Azure) *config.Azure {
+					return &in
+				}(func(in interface{}) config.Azure {
+					if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+						return config.Azure{}
+					}
+					return (ExpandConfigAzure(in.([]interface{})[0].(map[string]interface{})))
+				}(in))
+			}(in)
+		}(in["azure"]),
 		Klog: func(in interface{}) *config.Klog {
 			return func(in interface{}) *config.Klog {
 				if in == nil {
@@ -131,6 +188,42 @@ func FlattenConfigProviderInto(in config.Provider, out map[string]interface{}) {
 			}(*in)
 		}(in)
 	}(in.Openstack)
+	out["gce"] = func(in *config.Gce) interface{} {
+		return func(in *config.Gce) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in config.Gce) interface{} {
+				return func(in config.Gce) []interface{} {
+					return []interface{}{FlattenConfigGce(in)}
+				}(in)
+			}(*in)
+		}(in)
+	}(in.Gce)
+	out["digitalocean"] = func(in *config.DigitalOcean) interface{} {
+		return func(in *config.DigitalOcean) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in config.DigitalOcean) interface{} {
+				return func(in config.DigitalOcean) []interface{} {
+					return []interface{}{FlattenConfigDigitalOcean(in)}
+				}(in)
+			}(*in)
+		}(in)
+	}(in.DigitalOcean)
+	out["azure"] = func(in *config.Azure) interface{} {
+		return func(in *config.Azure) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in config.Azure) interface{} {
+				return func(in config.Azure) []interface{} {
+					return []interface{}{FlattenConfigAzure(in)}
+				}(in)
+			}(*in)
+		}(in)
+	}(in.Azure)
 	out["klog"] = func(in *config.Klog) interface{} {
 		return func(in *config.Klog) interface{} {
 			if in == nil {
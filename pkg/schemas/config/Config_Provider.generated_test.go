@@ -24,6 +24,9 @@ func TestExpandConfigProvider(t *testing.T) {
 					"state_store":   "",
 					"aws":           nil,
 					"openstack":     nil,
+					"gce":           nil,
+					"digitalocean":  nil,
+					"azure":         nil,
 					"klog":          nil,
 					"mock":          false,
 					"feature_flags": func() []interface{} { return nil }(),
@@ -47,6 +50,9 @@ func TestFlattenConfigProviderInto(t *testing.T) {
 		"state_store":   "",
 		"aws":           nil,
 		"openstack":     nil,
+		"gce":           nil,
+		"digitalocean":  nil,
+		"azure":         nil,
 		"klog":          nil,
 		"mock":          false,
 		"feature_flags": func() []interface{} { return nil }(),
@@ -99,6 +105,39 @@ func TestFlattenConfigProviderInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "Gce - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.Gce = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Digitalocean - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.DigitalOcean = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Azure - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.Azure = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Klog - default",
 			args: args{
@@ -149,6 +188,9 @@ func TestFlattenConfigProvider(t *testing.T) {
 		"state_store":   "",
 		"aws":           nil,
 		"openstack":     nil,
+		"gce":           nil,
+		"digitalocean":  nil,
+		"azure":         nil,
 		"klog":          nil,
 		"mock":          false,
 		"feature_flags": func() []interface{} { return nil }(),
@@ -201,6 +243,39 @@ func TestFlattenConfigProvider(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "Gce - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.Gce = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Digitalocean - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.DigitalOcean = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Azure - default",
+			args: args{
+				in: func() config.Provider {
+					subject := config.Provider{}
+					subject.Azure = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Klog - default",
 			args: args{
@@ -0,0 +1,87 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceClusterChanges() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_name":         RequiredString(),
+			"allow_kops_downgrade": OptionalComputedBool(),
+			"needs_apply":          ComputedBool(),
+			"changes":              ComputedList(utilsschemas.DataSourceClusterChange()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceClusterChanges(in map[string]interface{}) datasources.ClusterChanges {
+	if in == nil {
+		panic("expand ClusterChanges failure, in is nil")
+	}
+	return datasources.ClusterChanges{
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		AllowKopsDowngrade: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["allow_kops_downgrade"]),
+		NeedsApply: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["needs_apply"]),
+		Changes: func(in interface{}) []utils.ClusterChange {
+			return func(in interface{}) []utils.ClusterChange {
+				if in == nil {
+					return nil
+				}
+				var out []utils.ClusterChange
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.ClusterChange {
+						if in == nil {
+							return utils.ClusterChange{}
+						}
+						return (utilsschemas.ExpandDataSourceClusterChange(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["changes"]),
+	}
+}
+
+func FlattenDataSourceClusterChangesInto(in datasources.ClusterChanges, out map[string]interface{}) {
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["allow_kops_downgrade"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.AllowKopsDowngrade)
+	out["needs_apply"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.NeedsApply)
+	out["changes"] = func(in []utils.ClusterChange) interface{} {
+		return func(in []utils.ClusterChange) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.ClusterChange) interface{} {
+					return utilsschemas.FlattenDataSourceClusterChange(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Changes)
+}
+
+func FlattenDataSourceClusterChanges(in datasources.ClusterChanges) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceClusterChangesInto(in, out)
+	return out
+}
@@ -0,0 +1,196 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceClusterChanges(t *testing.T) {
+	_default := datasources.ClusterChanges{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.ClusterChanges
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"cluster_name":         "",
+					"allow_kops_downgrade": false,
+					"needs_apply":          false,
+					"changes":              func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceClusterChanges(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceClusterChanges() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChangesInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":         "",
+		"allow_kops_downgrade": false,
+		"needs_apply":          false,
+		"changes":              func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.ClusterChanges
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.ClusterChanges{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "AllowKopsDowngrade - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.AllowKopsDowngrade = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsApply - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.NeedsApply = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Changes - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.Changes = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceClusterChangesInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChanges() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChanges(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":         "",
+		"allow_kops_downgrade": false,
+		"needs_apply":          false,
+		"changes":              func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.ClusterChanges
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.ClusterChanges{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "AllowKopsDowngrade - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.AllowKopsDowngrade = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsApply - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.NeedsApply = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Changes - default",
+			args: args{
+				in: func() datasources.ClusterChanges {
+					subject := datasources.ClusterChanges{}
+					subject.Changes = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceClusterChanges(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChanges() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
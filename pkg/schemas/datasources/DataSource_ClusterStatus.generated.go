@@ -2,7 +2,9 @@ package schemas
 
 import (
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,11 +13,13 @@ var _ = Schema
 func DataSourceClusterStatus() *schema.Resource {
 	res := &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			"cluster_name":    RequiredString(),
-			"exists":          ComputedBool(),
-			"is_valid":        ComputedBool(),
-			"needs_update":    ComputedBool(),
-			"instance_groups": ComputedList(String()),
+			"cluster_name":        RequiredString(),
+			"exists":              ComputedBool(),
+			"is_valid":            ComputedBool(),
+			"validation_failures": ComputedList(utilsschemas.DataSourceValidationFailure()),
+			"nodes":               ComputedList(utilsschemas.DataSourceValidationNode()),
+			"needs_update":        ComputedBool(),
+			"instance_groups":     ComputedList(String()),
 		},
 	}
 
@@ -36,6 +40,40 @@ func ExpandDataSourceClusterStatus(in map[string]interface{}) datasources.Cluste
 		IsValid: func(in interface{}) bool {
 			return bool(ExpandBool(in))
 		}(in["is_valid"]),
+		ValidationFailures: func(in interface{}) []utils.ValidationFailure {
+			return func(in interface{}) []utils.ValidationFailure {
+				if in == nil {
+					return nil
+				}
+				var out []utils.ValidationFailure
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.ValidationFailure {
+						if in == nil {
+							return utils.ValidationFailure{}
+						}
+						return (utilsschemas.ExpandDataSourceValidationFailure(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["validation_failures"]),
+		Nodes: func(in interface{}) []utils.ValidationNode {
+			return func(in interface{}) []utils.ValidationNode {
+				if in == nil {
+					return nil
+				}
+				var out []utils.ValidationNode
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.ValidationNode {
+						if in == nil {
+							return utils.ValidationNode{}
+						}
+						return (utilsschemas.ExpandDataSourceValidationNode(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["nodes"]),
 		NeedsUpdate: func(in interface{}) bool {
 			return bool(ExpandBool(in))
 		}(in["needs_update"]),
@@ -64,6 +102,28 @@ func FlattenDataSourceClusterStatusInto(in datasources.ClusterStatus, out map[st
 	out["is_valid"] = func(in bool) interface{} {
 		return FlattenBool(bool(in))
 	}(in.IsValid)
+	out["validation_failures"] = func(in []utils.ValidationFailure) interface{} {
+		return func(in []utils.ValidationFailure) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.ValidationFailure) interface{} {
+					return utilsschemas.FlattenDataSourceValidationFailure(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.ValidationFailures)
+	out["nodes"] = func(in []utils.ValidationNode) interface{} {
+		return func(in []utils.ValidationNode) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.ValidationNode) interface{} {
+					return utilsschemas.FlattenDataSourceValidationNode(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Nodes)
 	out["needs_update"] = func(in bool) interface{} {
 		return FlattenBool(bool(in))
 	}(in.NeedsUpdate)
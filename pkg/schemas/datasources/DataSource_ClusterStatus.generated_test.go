@@ -21,11 +21,13 @@ func TestExpandDataSourceClusterStatus(t *testing.T) {
 			name: "default",
 			args: args{
 				in: map[string]interface{}{
-					"cluster_name":    "",
-					"exists":          false,
-					"is_valid":        false,
-					"needs_update":    false,
-					"instance_groups": func() []interface{} { return nil }(),
+					"cluster_name":        "",
+					"exists":              false,
+					"is_valid":            false,
+					"validation_failures": func() []interface{} { return nil }(),
+					"nodes":               func() []interface{} { return nil }(),
+					"needs_update":        false,
+					"instance_groups":     func() []interface{} { return nil }(),
 				},
 			},
 			want: _default,
@@ -43,11 +45,13 @@ func TestExpandDataSourceClusterStatus(t *testing.T) {
 
 func TestFlattenDataSourceClusterStatusInto(t *testing.T) {
 	_default := map[string]interface{}{
-		"cluster_name":    "",
-		"exists":          false,
-		"is_valid":        false,
-		"needs_update":    false,
-		"instance_groups": func() []interface{} { return nil }(),
+		"cluster_name":        "",
+		"exists":              false,
+		"is_valid":            false,
+		"validation_failures": func() []interface{} { return nil }(),
+		"nodes":               func() []interface{} { return nil }(),
+		"needs_update":        false,
+		"instance_groups":     func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in datasources.ClusterStatus
@@ -97,6 +101,28 @@ func TestFlattenDataSourceClusterStatusInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "ValidationFailures - default",
+			args: args{
+				in: func() datasources.ClusterStatus {
+					subject := datasources.ClusterStatus{}
+					subject.ValidationFailures = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Nodes - default",
+			args: args{
+				in: func() datasources.ClusterStatus {
+					subject := datasources.ClusterStatus{}
+					subject.Nodes = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "NeedsUpdate - default",
 			args: args{
@@ -133,11 +159,13 @@ func TestFlattenDataSourceClusterStatusInto(t *testing.T) {
 
 func TestFlattenDataSourceClusterStatus(t *testing.T) {
 	_default := map[string]interface{}{
-		"cluster_name":    "",
-		"exists":          false,
-		"is_valid":        false,
-		"needs_update":    false,
-		"instance_groups": func() []interface{} { return nil }(),
+		"cluster_name":        "",
+		"exists":              false,
+		"is_valid":            false,
+		"validation_failures": func() []interface{} { return nil }(),
+		"nodes":               func() []interface{} { return nil }(),
+		"needs_update":        false,
+		"instance_groups":     func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in datasources.ClusterStatus
@@ -187,6 +215,28 @@ func TestFlattenDataSourceClusterStatus(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "ValidationFailures - default",
+			args: args{
+				in: func() datasources.ClusterStatus {
+					subject := datasources.ClusterStatus{}
+					subject.ValidationFailures = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Nodes - default",
+			args: args{
+				in: func() datasources.ClusterStatus {
+					subject := datasources.ClusterStatus{}
+					subject.Nodes = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "NeedsUpdate - default",
 			args: args{
@@ -0,0 +1,85 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceClusterSummary() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":               ComputedString(),
+			"kubernetes_version": ComputedString(),
+			"cloud_provider":     ComputedString(),
+			"creation_timestamp": ComputedString(),
+			"instance_groups":    ComputedList(String()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceClusterSummary(in map[string]interface{}) datasources.ClusterSummary {
+	if in == nil {
+		panic("expand ClusterSummary failure, in is nil")
+	}
+	return datasources.ClusterSummary{
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		KubernetesVersion: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["kubernetes_version"]),
+		CloudProvider: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cloud_provider"]),
+		CreationTimestamp: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["creation_timestamp"]),
+		InstanceGroups: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["instance_groups"]),
+	}
+}
+
+func FlattenDataSourceClusterSummaryInto(in datasources.ClusterSummary, out map[string]interface{}) {
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["kubernetes_version"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.KubernetesVersion)
+	out["cloud_provider"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.CloudProvider)
+	out["creation_timestamp"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.CreationTimestamp)
+	out["instance_groups"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroups)
+}
+
+func FlattenDataSourceClusterSummary(in datasources.ClusterSummary) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceClusterSummaryInto(in, out)
+	return out
+}
@@ -0,0 +1,221 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceClusterSummary(t *testing.T) {
+	_default := datasources.ClusterSummary{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.ClusterSummary
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"name":               "",
+					"kubernetes_version": "",
+					"cloud_provider":     "",
+					"creation_timestamp": "",
+					"instance_groups":    func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceClusterSummary(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceClusterSummary() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterSummaryInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"name":               "",
+		"kubernetes_version": "",
+		"cloud_provider":     "",
+		"creation_timestamp": "",
+		"instance_groups":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.ClusterSummary
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.ClusterSummary{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "KubernetesVersion - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.KubernetesVersion = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CloudProvider - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.CloudProvider = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CreationTimestamp - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.CreationTimestamp = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceClusterSummaryInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterSummary() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterSummary(t *testing.T) {
+	_default := map[string]interface{}{
+		"name":               "",
+		"kubernetes_version": "",
+		"cloud_provider":     "",
+		"creation_timestamp": "",
+		"instance_groups":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.ClusterSummary
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.ClusterSummary{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "KubernetesVersion - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.KubernetesVersion = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CloudProvider - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.CloudProvider = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CreationTimestamp - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.CreationTimestamp = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.ClusterSummary {
+					subject := datasources.ClusterSummary{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceClusterSummary(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterSummary() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
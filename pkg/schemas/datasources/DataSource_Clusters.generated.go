@@ -0,0 +1,101 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceClusters() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name_prefix": OptionalComputedString(),
+			"labels":      OptionalComputedMap(String()),
+			"clusters":    ComputedList(DataSourceClusterSummary()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceClusters(in map[string]interface{}) datasources.Clusters {
+	if in == nil {
+		panic("expand Clusters failure, in is nil")
+	}
+	return datasources.Clusters{
+		NamePrefix: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name_prefix"]),
+		Labels: func(in interface{}) map[string]string {
+			return func(in interface{}) map[string]string {
+				if in == nil {
+					return nil
+				}
+				if in, ok := in.(map[string]interface{}); ok {
+					if len(in) > 0 {
+						out := map[string]string{}
+						for key, in := range in {
+							out[key] = string(ExpandString(in))
+						}
+						return out
+					}
+				}
+				return nil
+			}(in)
+		}(in["labels"]),
+		Clusters: func(in interface{}) []datasources.ClusterSummary {
+			return func(in interface{}) []datasources.ClusterSummary {
+				if in == nil {
+					return nil
+				}
+				var out []datasources.ClusterSummary
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) datasources.ClusterSummary {
+						if in == nil {
+							return datasources.ClusterSummary{}
+						}
+						return (ExpandDataSourceClusterSummary(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["clusters"]),
+	}
+}
+
+func FlattenDataSourceClustersInto(in datasources.Clusters, out map[string]interface{}) {
+	out["name_prefix"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.NamePrefix)
+	out["labels"] = func(in map[string]string) interface{} {
+		return func(in map[string]string) map[string]interface{} {
+			if in == nil {
+				return nil
+			}
+			out := map[string]interface{}{}
+			for key, in := range in {
+				out[key] = FlattenString(string(in))
+			}
+			return out
+		}(in)
+	}(in.Labels)
+	out["clusters"] = func(in []datasources.ClusterSummary) interface{} {
+		return func(in []datasources.ClusterSummary) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in datasources.ClusterSummary) interface{} {
+					return FlattenDataSourceClusterSummary(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Clusters)
+}
+
+func FlattenDataSourceClusters(in datasources.Clusters) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceClustersInto(in, out)
+	return out
+}
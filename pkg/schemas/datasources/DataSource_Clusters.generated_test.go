@@ -0,0 +1,171 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceClusters(t *testing.T) {
+	_default := datasources.Clusters{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.Clusters
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"name_prefix": "",
+					"labels":      func() map[string]interface{} { return nil }(),
+					"clusters":    func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceClusters(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceClusters() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClustersInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"name_prefix": "",
+		"labels":      func() map[string]interface{} { return nil }(),
+		"clusters":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.Clusters
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.Clusters{},
+			},
+			want: _default,
+		},
+		{
+			name: "NamePrefix - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.NamePrefix = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Labels - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.Labels = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Clusters - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.Clusters = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceClustersInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusters() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusters(t *testing.T) {
+	_default := map[string]interface{}{
+		"name_prefix": "",
+		"labels":      func() map[string]interface{} { return nil }(),
+		"clusters":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.Clusters
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.Clusters{},
+			},
+			want: _default,
+		},
+		{
+			name: "NamePrefix - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.NamePrefix = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Labels - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.Labels = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Clusters - default",
+			args: args{
+				in: func() datasources.Clusters {
+					subject := datasources.Clusters{}
+					subject.Clusters = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceClusters(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusters() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
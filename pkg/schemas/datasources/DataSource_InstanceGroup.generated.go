@@ -0,0 +1,151 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	kopsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/kops"
+	resourcesschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstanceGroup() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role":                              ComputedString(),
+			"image":                             ComputedString(),
+			"min_size":                          ComputedInt(),
+			"max_size":                          ComputedInt(),
+			"autoscale":                         ComputedBool(),
+			"machine_type":                      ComputedString(),
+			"root_volume_size":                  ComputedInt(),
+			"root_volume_type":                  ComputedString(),
+			"root_volume_iops":                  ComputedInt(),
+			"root_volume_throughput":            ComputedInt(),
+			"root_volume_optimization":          ComputedBool(),
+			"root_volume_delete_on_termination": ComputedBool(),
+			"root_volume_encryption":            ComputedBool(),
+			"root_volume_encryption_key":        ComputedString(),
+			"volumes":                           ComputedList(kopsschemas.DataSourceVolumeSpec()),
+			"volume_mounts":                     ComputedList(kopsschemas.DataSourceVolumeMountSpec()),
+			"subnets":                           ComputedList(String()),
+			"zones":                             ComputedList(String()),
+			"hooks":                             ComputedList(kopsschemas.DataSourceHookSpec()),
+			"max_price":                         ComputedString(),
+			"spot_duration_in_minutes":          ComputedInt(),
+			"cpu_credits":                       ComputedString(),
+			"associate_public_ip":               ComputedBool(),
+			"additional_security_groups":        ComputedList(String()),
+			"cloud_labels":                      ComputedMap(String()),
+			"node_labels":                       ComputedMap(String()),
+			"file_assets":                       ComputedList(kopsschemas.DataSourceFileAssetSpec()),
+			"tenancy":                           ComputedString(),
+			"kubelet":                           ComputedStruct(kopsschemas.DataSourceKubeletConfigSpec()),
+			"taints":                            ComputedList(String()),
+			"mixed_instances_policy":            ComputedStruct(kopsschemas.DataSourceMixedInstancesPolicySpec()),
+			"additional_user_data":              ComputedList(kopsschemas.DataSourceUserData()),
+			"suspend_processes":                 ComputedList(String()),
+			"external_load_balancers":           ComputedList(kopsschemas.DataSourceLoadBalancer()),
+			"detailed_instance_monitoring":      ComputedBool(),
+			"iam":                               ComputedStruct(kopsschemas.DataSourceIAMProfileSpec()),
+			"security_group_override":           ComputedString(),
+			"instance_protection":               ComputedBool(),
+			"sysctl_parameters":                 ComputedList(String()),
+			"rolling_update":                    ComputedStruct(kopsschemas.DataSourceRollingUpdate()),
+			"instance_interruption_behavior":    ComputedString(),
+			"compress_user_data":                ComputedBool(),
+			"instance_metadata":                 ComputedStruct(kopsschemas.DataSourceInstanceMetadataOptions()),
+			"update_policy":                     ComputedString(),
+			"warm_pool":                         ComputedStruct(kopsschemas.DataSourceWarmPoolSpec()),
+			"cluster_name":                      RequiredString(),
+			"name":                              RequiredString(),
+			"deletion_protection":               ComputedBool(),
+			"include_cloud_status":              OptionalComputedBool(),
+			"cloud_status":                      ComputedStruct(utilsschemas.DataSourceInstanceGroupCloudStatus()),
+		},
+	}
+	res.SchemaVersion = 2
+	res.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type: res.CoreConfigSchema().ImpliedType(),
+			Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+				ret := FlattenDataSourceInstanceGroup(ExpandDataSourceInstanceGroup(rawState))
+				ret["id"] = rawState["id"]
+				return ret, nil
+			},
+			Version: 0,
+		}, {
+			Type: res.CoreConfigSchema().ImpliedType(),
+			Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+				ret := FlattenDataSourceInstanceGroup(ExpandDataSourceInstanceGroup(rawState))
+				ret["id"] = rawState["id"]
+				return ret, nil
+			},
+			Version: 1,
+		},
+	}
+	return res
+}
+
+func ExpandDataSourceInstanceGroup(in map[string]interface{}) datasources.InstanceGroup {
+	if in == nil {
+		panic("expand InstanceGroup failure, in is nil")
+	}
+	return datasources.InstanceGroup{
+		InstanceGroup: func(in interface{}) resources.InstanceGroup {
+			return resourcesschemas.ExpandDataSourceInstanceGroup(in.(map[string]interface{}))
+		}(in),
+		IncludeCloudStatus: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["include_cloud_status"]),
+		CloudStatus: func(in interface{}) *utils.InstanceGroupCloudStatus {
+			return func(in interface{}) *utils.InstanceGroupCloudStatus {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in utils.InstanceGroupCloudStatus) *utils.InstanceGroupCloudStatus {
+					return &in
+				}(func(in interface{}) utils.InstanceGroupCloudStatus {
+					if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+						return utils.InstanceGroupCloudStatus{}
+					}
+					return (utilsschemas.ExpandDataSourceInstanceGroupCloudStatus(in.([]interface{})[0].(map[string]interface{})))
+				}(in))
+			}(in)
+		}(in["cloud_status"]),
+	}
+}
+
+func FlattenDataSourceInstanceGroupInto(in datasources.InstanceGroup, out map[string]interface{}) {
+	resourcesschemas.FlattenDataSourceInstanceGroupInto(in.InstanceGroup, out)
+	out["include_cloud_status"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.IncludeCloudStatus)
+	out["cloud_status"] = func(in *utils.InstanceGroupCloudStatus) interface{} {
+		return func(in *utils.InstanceGroupCloudStatus) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in utils.InstanceGroupCloudStatus) interface{} {
+				return func(in utils.InstanceGroupCloudStatus) []interface{} {
+					return []interface{}{utilsschemas.FlattenDataSourceInstanceGroupCloudStatus(in)}
+				}(in)
+			}(*in)
+		}(in)
+	}(in.CloudStatus)
+}
+
+func FlattenDataSourceInstanceGroup(in datasources.InstanceGroup) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstanceGroupInto(in, out)
+	return out
+}
@@ -0,0 +1,139 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	resourcesschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstanceGroups() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_name":    RequiredString(),
+			"roles":           OptionalComputedList(String()),
+			"subnets":         OptionalComputedList(String()),
+			"zones":           OptionalComputedList(String()),
+			"instance_groups": ComputedList(resourcesschemas.DataSourceInstanceGroup()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceInstanceGroups(in map[string]interface{}) datasources.InstanceGroups {
+	if in == nil {
+		panic("expand InstanceGroups failure, in is nil")
+	}
+	return datasources.InstanceGroups{
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		Roles: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["roles"]),
+		Subnets: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["subnets"]),
+		Zones: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["zones"]),
+		InstanceGroups: func(in interface{}) []resources.InstanceGroup {
+			return func(in interface{}) []resources.InstanceGroup {
+				if in == nil {
+					return nil
+				}
+				var out []resources.InstanceGroup
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) resources.InstanceGroup {
+						if in == nil {
+							return resources.InstanceGroup{}
+						}
+						return (resourcesschemas.ExpandDataSourceInstanceGroup(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["instance_groups"]),
+	}
+}
+
+func FlattenDataSourceInstanceGroupsInto(in datasources.InstanceGroups, out map[string]interface{}) {
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["roles"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.Roles)
+	out["subnets"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.Subnets)
+	out["zones"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.Zones)
+	out["instance_groups"] = func(in []resources.InstanceGroup) interface{} {
+		return func(in []resources.InstanceGroup) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in resources.InstanceGroup) interface{} {
+					return resourcesschemas.FlattenDataSourceInstanceGroup(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroups)
+}
+
+func FlattenDataSourceInstanceGroups(in datasources.InstanceGroups) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstanceGroupsInto(in, out)
+	return out
+}
@@ -0,0 +1,221 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceInstanceGroups(t *testing.T) {
+	_default := datasources.InstanceGroups{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.InstanceGroups
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"cluster_name":    "",
+					"roles":           func() []interface{} { return nil }(),
+					"subnets":         func() []interface{} { return nil }(),
+					"zones":           func() []interface{} { return nil }(),
+					"instance_groups": func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceInstanceGroups(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceInstanceGroups() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroupsInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":    "",
+		"roles":           func() []interface{} { return nil }(),
+		"subnets":         func() []interface{} { return nil }(),
+		"zones":           func() []interface{} { return nil }(),
+		"instance_groups": func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.InstanceGroups
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.InstanceGroups{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Roles - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Roles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Subnets - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Subnets = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Zones - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Zones = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceInstanceGroupsInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroups() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroups(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":    "",
+		"roles":           func() []interface{} { return nil }(),
+		"subnets":         func() []interface{} { return nil }(),
+		"zones":           func() []interface{} { return nil }(),
+		"instance_groups": func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.InstanceGroups
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.InstanceGroups{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Roles - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Roles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Subnets - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Subnets = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Zones - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.Zones = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.InstanceGroups {
+					subject := datasources.InstanceGroups{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceInstanceGroups(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroups() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstances() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_name": RequiredString(),
+			"instances":    ComputedList(utilsschemas.DataSourceInstance()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceInstances(in map[string]interface{}) datasources.Instances {
+	if in == nil {
+		panic("expand Instances failure, in is nil")
+	}
+	return datasources.Instances{
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		Instances: func(in interface{}) []utils.Instance {
+			return func(in interface{}) []utils.Instance {
+				if in == nil {
+					return nil
+				}
+				var out []utils.Instance
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.Instance {
+						if in == nil {
+							return utils.Instance{}
+						}
+						return (utilsschemas.ExpandDataSourceInstance(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["instances"]),
+	}
+}
+
+func FlattenDataSourceInstancesInto(in datasources.Instances, out map[string]interface{}) {
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["instances"] = func(in []utils.Instance) interface{} {
+		return func(in []utils.Instance) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.Instance) interface{} {
+					return utilsschemas.FlattenDataSourceInstance(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Instances)
+}
+
+func FlattenDataSourceInstances(in datasources.Instances) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstancesInto(in, out)
+	return out
+}
@@ -0,0 +1,146 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceInstances(t *testing.T) {
+	_default := datasources.Instances{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.Instances
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"cluster_name": "",
+					"instances":    func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceInstances(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceInstances() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstancesInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name": "",
+		"instances":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.Instances
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.Instances{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.Instances {
+					subject := datasources.Instances{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Instances - default",
+			args: args{
+				in: func() datasources.Instances {
+					subject := datasources.Instances{}
+					subject.Instances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceInstancesInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstances() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstances(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name": "",
+		"instances":    func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in datasources.Instances
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.Instances{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.Instances {
+					subject := datasources.Instances{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Instances - default",
+			args: args{
+				in: func() datasources.Instances {
+					subject := datasources.Instances{}
+					subject.Instances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceInstances(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstances() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceRollingUpdateStatus() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_name":              RequiredString(),
+			"exists":                    ComputedBool(),
+			"status":                    ComputedString(),
+			"started_at":                ComputedString(),
+			"finished_at":               ComputedString(),
+			"resumes":                   ComputedInt(),
+			"instance_groups":           ComputedList(String()),
+			"instance_group_roles":      ComputedList(String()),
+			"completed_instance_groups": ComputedList(String()),
+			"current_instance_group":    ComputedString(),
+			"pending_instances":         ComputedList(String()),
+			"replaced_instances":        ComputedList(String()),
+			"last_error":                ComputedString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceRollingUpdateStatus(in map[string]interface{}) datasources.RollingUpdateStatus {
+	if in == nil {
+		panic("expand RollingUpdateStatus failure, in is nil")
+	}
+	return datasources.RollingUpdateStatus{
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		Exists: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["exists"]),
+		RollingUpdateProgress: func(in interface{}) utils.RollingUpdateProgress {
+			return utilsschemas.ExpandDataSourceRollingUpdateProgress(in.(map[string]interface{}))
+		}(in),
+	}
+}
+
+func FlattenDataSourceRollingUpdateStatusInto(in datasources.RollingUpdateStatus, out map[string]interface{}) {
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["exists"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.Exists)
+	utilsschemas.FlattenDataSourceRollingUpdateProgressInto(in.RollingUpdateProgress, out)
+}
+
+func FlattenDataSourceRollingUpdateStatus(in datasources.RollingUpdateStatus) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceRollingUpdateStatusInto(in, out)
+	return out
+}
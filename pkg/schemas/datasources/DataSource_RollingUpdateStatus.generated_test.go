@@ -0,0 +1,421 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/datasources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceRollingUpdateStatus(t *testing.T) {
+	_default := datasources.RollingUpdateStatus{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want datasources.RollingUpdateStatus
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"cluster_name":              "",
+					"exists":                    false,
+					"status":                    "",
+					"started_at":                "",
+					"finished_at":               "",
+					"resumes":                   0,
+					"instance_groups":           func() []interface{} { return nil }(),
+					"instance_group_roles":      func() []interface{} { return nil }(),
+					"completed_instance_groups": func() []interface{} { return nil }(),
+					"current_instance_group":    "",
+					"pending_instances":         func() []interface{} { return nil }(),
+					"replaced_instances":        func() []interface{} { return nil }(),
+					"last_error":                "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceRollingUpdateStatus(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceRollingUpdateStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceRollingUpdateStatusInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":              "",
+		"exists":                    false,
+		"status":                    "",
+		"started_at":                "",
+		"finished_at":               "",
+		"resumes":                   0,
+		"instance_groups":           func() []interface{} { return nil }(),
+		"instance_group_roles":      func() []interface{} { return nil }(),
+		"completed_instance_groups": func() []interface{} { return nil }(),
+		"current_instance_group":    "",
+		"pending_instances":         func() []interface{} { return nil }(),
+		"replaced_instances":        func() []interface{} { return nil }(),
+		"last_error":                "",
+	}
+	type args struct {
+		in datasources.RollingUpdateStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.RollingUpdateStatus{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Exists - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Exists = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StartedAt - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.StartedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FinishedAt - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.FinishedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Resumes - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Resumes = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CompletedInstanceGroups - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.CompletedInstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CurrentInstanceGroup - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.CurrentInstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PendingInstances - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.PendingInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReplacedInstances - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.ReplacedInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "LastError - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.LastError = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceRollingUpdateStatusInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceRollingUpdateStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceRollingUpdateStatus(t *testing.T) {
+	_default := map[string]interface{}{
+		"cluster_name":              "",
+		"exists":                    false,
+		"status":                    "",
+		"started_at":                "",
+		"finished_at":               "",
+		"resumes":                   0,
+		"instance_groups":           func() []interface{} { return nil }(),
+		"instance_group_roles":      func() []interface{} { return nil }(),
+		"completed_instance_groups": func() []interface{} { return nil }(),
+		"current_instance_group":    "",
+		"pending_instances":         func() []interface{} { return nil }(),
+		"replaced_instances":        func() []interface{} { return nil }(),
+		"last_error":                "",
+	}
+	type args struct {
+		in datasources.RollingUpdateStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: datasources.RollingUpdateStatus{},
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Exists - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Exists = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StartedAt - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.StartedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FinishedAt - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.FinishedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Resumes - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.Resumes = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CompletedInstanceGroups - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.CompletedInstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CurrentInstanceGroup - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.CurrentInstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PendingInstances - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.PendingInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReplacedInstances - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.ReplacedInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "LastError - default",
+			args: args{
+				in: func() datasources.RollingUpdateStatus {
+					subject := datasources.RollingUpdateStatus{}
+					subject.LastError = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceRollingUpdateStatus(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceRollingUpdateStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
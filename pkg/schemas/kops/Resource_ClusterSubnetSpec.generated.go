@@ -15,7 +15,7 @@ func ResourceClusterSubnetSpec() *schema.Resource {
 			"cidr":        OptionalComputedString(),
 			"zone":        RequiredString(),
 			"region":      OptionalString(),
-			"provider_id": RequiredString(),
+			"provider_id": OptionalString(),
 			"egress":      OptionalString(),
 			"type":        RequiredString(),
 			"public_ip":   OptionalString(),
@@ -86,7 +86,9 @@ func DataSourceCluster() *schema.Resource {
 			"snapshot_controller":               ComputedStruct(kopsschemas.DataSourceSnapshotControllerConfig()),
 			"name":                              RequiredString(),
 			"admin_ssh_key":                     ComputedString(),
+			"admin_ssh_keys":                    ComputedList(String()),
 			"secrets":                           ComputedStruct(DataSourceClusterSecrets()),
+			"deletion_protection":               ComputedBool(),
 		},
 	}
 	res.SchemaVersion = 2
@@ -126,6 +128,18 @@ func ExpandDataSourceCluster(in map[string]interface{}) resources.Cluster {
 		AdminSshKey: func(in interface{}) string {
 			return string(ExpandString(in))
 		}(in["admin_ssh_key"]),
+		AdminSshKeys: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["admin_ssh_keys"]),
 		Secrets: func(in interface{}) *resources.ClusterSecrets {
 			return func(in interface{}) *resources.ClusterSecrets {
 				if in == nil {
@@ -144,6 +158,9 @@ func ExpandDataSourceCluster(in map[string]interface{}) resources.Cluster {
 				}(in))
 			}(in)
 		}(in["secrets"]),
+		DeletionProtection: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["deletion_protection"]),
 	}
 }
 
@@ -155,6 +172,15 @@ func FlattenDataSourceClusterInto(in resources.Cluster, out map[string]interface
 	out["admin_ssh_key"] = func(in string) interface{} {
 		return FlattenString(string(in))
 	}(in.AdminSshKey)
+	out["admin_ssh_keys"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.AdminSshKeys)
 	out["secrets"] = func(in *resources.ClusterSecrets) interface{} {
 		return func(in *resources.ClusterSecrets) interface{} {
 			if in == nil {
@@ -167,6 +193,9 @@ func FlattenDataSourceClusterInto(in resources.Cluster, out map[string]interface
 			}(*in)
 		}(in)
 	}(in.Secrets)
+	out["deletion_protection"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.DeletionProtection)
 }
 
 func FlattenDataSourceCluster(in resources.Cluster) map[string]interface{} {
@@ -92,7 +92,9 @@ func TestExpandDataSourceCluster(t *testing.T) {
 					"snapshot_controller":               nil,
 					"name":                              "",
 					"admin_ssh_key":                     "",
+					"admin_ssh_keys":                    func() []interface{} { return nil }(),
 					"secrets":                           nil,
+					"deletion_protection":               false,
 				},
 			},
 			want: _default,
@@ -181,7 +183,9 @@ func TestFlattenDataSourceClusterInto(t *testing.T) {
 		"snapshot_controller":               nil,
 		"name":                              "",
 		"admin_ssh_key":                     "",
+		"admin_ssh_keys":                    func() []interface{} { return nil }(),
 		"secrets":                           nil,
+		"deletion_protection":               false,
 	}
 	type args struct {
 		in resources.Cluster
@@ -979,6 +983,17 @@ func TestFlattenDataSourceClusterInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "AdminSshKeys - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.AdminSshKeys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Secrets - default",
 			args: args{
@@ -990,6 +1005,17 @@ func TestFlattenDataSourceClusterInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1075,7 +1101,9 @@ func TestFlattenDataSourceCluster(t *testing.T) {
 		"snapshot_controller":               nil,
 		"name":                              "",
 		"admin_ssh_key":                     "",
+		"admin_ssh_keys":                    func() []interface{} { return nil }(),
 		"secrets":                           nil,
+		"deletion_protection":               false,
 	}
 	type args struct {
 		in resources.Cluster
@@ -1873,6 +1901,17 @@ func TestFlattenDataSourceCluster(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "AdminSshKeys - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.AdminSshKeys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Secrets - default",
 			args: args{
@@ -1884,6 +1923,17 @@ func TestFlattenDataSourceCluster(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -62,6 +62,7 @@ func DataSourceInstanceGroup() *schema.Resource {
 			"warm_pool":                         ComputedStruct(kopsschemas.DataSourceWarmPoolSpec()),
 			"cluster_name":                      RequiredString(),
 			"name":                              RequiredString(),
+			"deletion_protection":               ComputedBool(),
 		},
 	}
 	res.SchemaVersion = 2
@@ -101,6 +102,9 @@ func ExpandDataSourceInstanceGroup(in map[string]interface{}) resources.Instance
 		Name: func(in interface{}) string {
 			return string(ExpandString(in))
 		}(in["name"]),
+		DeletionProtection: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["deletion_protection"]),
 	}
 }
 
@@ -112,6 +116,9 @@ func FlattenDataSourceInstanceGroupInto(in resources.InstanceGroup, out map[stri
 	out["name"] = func(in string) interface{} {
 		return FlattenString(string(in))
 	}(in.Name)
+	out["deletion_protection"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.DeletionProtection)
 }
 
 func FlattenDataSourceInstanceGroup(in resources.InstanceGroup) map[string]interface{} {
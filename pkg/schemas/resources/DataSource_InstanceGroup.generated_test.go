@@ -68,6 +68,7 @@ func TestExpandDataSourceInstanceGroup(t *testing.T) {
 					"warm_pool":                         nil,
 					"cluster_name":                      "",
 					"name":                              "",
+					"deletion_protection":               false,
 				},
 			},
 			want: _default,
@@ -132,6 +133,7 @@ func TestFlattenDataSourceInstanceGroupInto(t *testing.T) {
 		"warm_pool":                         nil,
 		"cluster_name":                      "",
 		"name":                              "",
+		"deletion_protection":               false,
 	}
 	type args struct {
 		in resources.InstanceGroup
@@ -665,6 +667,17 @@ func TestFlattenDataSourceInstanceGroupInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -726,6 +739,7 @@ func TestFlattenDataSourceInstanceGroup(t *testing.T) {
 		"warm_pool":                         nil,
 		"cluster_name":                      "",
 		"name":                              "",
+		"deletion_protection":               false,
 	}
 	type args struct {
 		in resources.InstanceGroup
@@ -1259,6 +1273,17 @@ func TestFlattenDataSourceInstanceGroup(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2,7 +2,9 @@ package schemas
 
 import (
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,6 +15,8 @@ func ResourceApplyOptions() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"skip":                 OptionalBool(),
 			"allow_kops_downgrade": OptionalBool(),
+			"lifecycle_overrides":  OptionalMap(String()),
+			"phase":                OptionalString(),
 		},
 	}
 
@@ -27,9 +31,9 @@ func ExpandResourceApplyOptions(in map[string]interface{}) resources.ApplyOption
 		Skip: func(in interface{}) bool {
 			return bool(ExpandBool(in))
 		}(in["skip"]),
-		AllowKopsDowngrade: func(in interface{}) bool {
-			return bool(ExpandBool(in))
-		}(in["allow_kops_downgrade"]),
+		ApplyOptions: func(in interface{}) utils.ApplyOptions {
+			return utilsschemas.ExpandResourceApplyOptions(in.(map[string]interface{}))
+		}(in),
 	}
 }
 
@@ -37,9 +41,7 @@ func FlattenResourceApplyOptionsInto(in resources.ApplyOptions, out map[string]i
 	out["skip"] = func(in bool) interface{} {
 		return FlattenBool(bool(in))
 	}(in.Skip)
-	out["allow_kops_downgrade"] = func(in bool) interface{} {
-		return FlattenBool(bool(in))
-	}(in.AllowKopsDowngrade)
+	utilsschemas.FlattenResourceApplyOptionsInto(in.ApplyOptions, out)
 }
 
 func FlattenResourceApplyOptions(in resources.ApplyOptions) map[string]interface{} {
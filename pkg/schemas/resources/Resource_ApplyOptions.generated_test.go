@@ -23,6 +23,8 @@ func TestExpandResourceApplyOptions(t *testing.T) {
 				in: map[string]interface{}{
 					"skip":                 false,
 					"allow_kops_downgrade": false,
+					"lifecycle_overrides":  func() map[string]interface{} { return nil }(),
+					"phase":                "",
 				},
 			},
 			want: _default,
@@ -42,6 +44,8 @@ func TestFlattenResourceApplyOptionsInto(t *testing.T) {
 	_default := map[string]interface{}{
 		"skip":                 false,
 		"allow_kops_downgrade": false,
+		"lifecycle_overrides":  func() map[string]interface{} { return nil }(),
+		"phase":                "",
 	}
 	type args struct {
 		in resources.ApplyOptions
@@ -80,6 +84,28 @@ func TestFlattenResourceApplyOptionsInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "LifecycleOverrides - default",
+			args: args{
+				in: func() resources.ApplyOptions {
+					subject := resources.ApplyOptions{}
+					subject.LifecycleOverrides = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Phase - default",
+			args: args{
+				in: func() resources.ApplyOptions {
+					subject := resources.ApplyOptions{}
+					subject.Phase = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +122,8 @@ func TestFlattenResourceApplyOptions(t *testing.T) {
 	_default := map[string]interface{}{
 		"skip":                 false,
 		"allow_kops_downgrade": false,
+		"lifecycle_overrides":  func() map[string]interface{} { return nil }(),
+		"phase":                "",
 	}
 	type args struct {
 		in resources.ApplyOptions
@@ -134,6 +162,28 @@ func TestFlattenResourceApplyOptions(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "LifecycleOverrides - default",
+			args: args{
+				in: func() resources.ApplyOptions {
+					subject := resources.ApplyOptions{}
+					subject.LifecycleOverrides = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Phase - default",
+			args: args{
+				in: func() resources.ApplyOptions {
+					subject := resources.ApplyOptions{}
+					subject.Phase = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -27,7 +27,7 @@ func ResourceCluster() *schema.Resource {
 			"master_internal_name":              OptionalComputedString(),
 			"network_cidr":                      OptionalComputedString(),
 			"additional_network_cidrs":          OptionalList(String()),
-			"network_id":                        RequiredString(),
+			"network_id":                        OptionalString(),
 			"topology":                          RequiredStruct(kopsschemas.ResourceTopologySpec()),
 			"secret_store":                      OptionalString(),
 			"key_store":                         OptionalString(),
@@ -86,8 +86,11 @@ func ResourceCluster() *schema.Resource {
 			"snapshot_controller":               OptionalStruct(kopsschemas.ResourceSnapshotControllerConfig()),
 			"revision":                          ComputedInt(),
 			"name":                              ForceNew(RequiredString()),
-			"admin_ssh_key":                     Sensitive(RequiredString()),
+			"admin_ssh_key":                     Sensitive(OptionalString()),
+			"admin_ssh_keys":                    Sensitive(OptionalList(String())),
 			"secrets":                           OptionalStruct(ResourceClusterSecrets()),
+			"deletion_protection":               OptionalBool(),
+			"delete_mode":                       StringIn([]string{"destroy", "state_store_only", "orphan"}, OptionalString()),
 		},
 	}
 	res.SchemaVersion = 2
@@ -130,6 +133,18 @@ func ExpandResourceCluster(in map[string]interface{}) resources.Cluster {
 		AdminSshKey: func(in interface{}) string {
 			return string(ExpandString(in))
 		}(in["admin_ssh_key"]),
+		AdminSshKeys: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["admin_ssh_keys"]),
 		Secrets: func(in interface{}) *resources.ClusterSecrets {
 			return func(in interface{}) *resources.ClusterSecrets {
 				if in == nil {
@@ -148,6 +163,12 @@ func ExpandResourceCluster(in map[string]interface{}) resources.Cluster {
 				}(in))
 			}(in)
 		}(in["secrets"]),
+		DeletionProtection: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["deletion_protection"]),
+		DeleteMode: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["delete_mode"]),
 	}
 }
 
@@ -162,6 +183,15 @@ func FlattenResourceClusterInto(in resources.Cluster, out map[string]interface{}
 	out["admin_ssh_key"] = func(in string) interface{} {
 		return FlattenString(string(in))
 	}(in.AdminSshKey)
+	out["admin_ssh_keys"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.AdminSshKeys)
 	out["secrets"] = func(in *resources.ClusterSecrets) interface{} {
 		return func(in *resources.ClusterSecrets) interface{} {
 			if in == nil {
@@ -174,6 +204,12 @@ func FlattenResourceClusterInto(in resources.Cluster, out map[string]interface{}
 			}(*in)
 		}(in)
 	}(in.Secrets)
+	out["deletion_protection"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.DeletionProtection)
+	out["delete_mode"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.DeleteMode)
 }
 
 func FlattenResourceCluster(in resources.Cluster) map[string]interface{} {
@@ -93,7 +93,10 @@ func TestExpandResourceCluster(t *testing.T) {
 					"revision":                          0,
 					"name":                              "",
 					"admin_ssh_key":                     "",
+					"admin_ssh_keys":                    func() []interface{} { return nil }(),
 					"secrets":                           nil,
+					"deletion_protection":               false,
+					"delete_mode":                       "",
 				},
 			},
 			want: _default,
@@ -183,7 +186,10 @@ func TestFlattenResourceClusterInto(t *testing.T) {
 		"revision":                          0,
 		"name":                              "",
 		"admin_ssh_key":                     "",
+		"admin_ssh_keys":                    func() []interface{} { return nil }(),
 		"secrets":                           nil,
+		"deletion_protection":               false,
+		"delete_mode":                       "",
 	}
 	type args struct {
 		in resources.Cluster
@@ -992,6 +998,17 @@ func TestFlattenResourceClusterInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "AdminSshKeys - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.AdminSshKeys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Secrets - default",
 			args: args{
@@ -1003,6 +1020,28 @@ func TestFlattenResourceClusterInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DeleteMode - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeleteMode = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1089,7 +1128,10 @@ func TestFlattenResourceCluster(t *testing.T) {
 		"revision":                          0,
 		"name":                              "",
 		"admin_ssh_key":                     "",
+		"admin_ssh_keys":                    func() []interface{} { return nil }(),
 		"secrets":                           nil,
+		"deletion_protection":               false,
+		"delete_mode":                       "",
 	}
 	type args struct {
 		in resources.Cluster
@@ -1898,6 +1940,17 @@ func TestFlattenResourceCluster(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "AdminSshKeys - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.AdminSshKeys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 		{
 			name: "Secrets - default",
 			args: args{
@@ -1909,6 +1962,28 @@ func TestFlattenResourceCluster(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DeleteMode - default",
+			args: args{
+				in: func() resources.Cluster {
+					subject := resources.Cluster{}
+					subject.DeleteMode = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
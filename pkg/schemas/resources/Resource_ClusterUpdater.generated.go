@@ -11,12 +11,14 @@ var _ = Schema
 func ResourceClusterUpdater() *schema.Resource {
 	res := &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			"revision":       ComputedInt(),
-			"cluster_name":   RequiredString(),
-			"keepers":        OptionalMap(String()),
-			"apply":          OptionalStruct(ResourceApplyOptions()),
-			"rolling_update": OptionalStruct(ResourceRollingUpdateOptions()),
-			"validate":       OptionalStruct(ResourceValidateOptions()),
+			"revision":             ComputedInt(),
+			"cluster_name":         RequiredString(),
+			"keepers":              OptionalMap(String()),
+			"apply":                OptionalStruct(ResourceApplyOptions()),
+			"rolling_update":       OptionalStruct(ResourceRollingUpdateOptions()),
+			"validate":             OptionalStruct(ResourceValidateOptions()),
+			"needs_apply":          ComputedBool(),
+			"needs_rolling_update": ComputedBool(),
 		},
 	}
 
@@ -75,6 +77,12 @@ func ExpandResourceClusterUpdater(in map[string]interface{}) resources.ClusterUp
 				return (ExpandResourceValidateOptions(in.([]interface{})[0].(map[string]interface{})))
 			}(in)
 		}(in["validate"]),
+		NeedsApply: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["needs_apply"]),
+		NeedsRollingUpdate: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["needs_rolling_update"]),
 	}
 }
 
@@ -112,6 +120,12 @@ func FlattenResourceClusterUpdaterInto(in resources.ClusterUpdater, out map[stri
 			return []interface{}{FlattenResourceValidateOptions(in)}
 		}(in)
 	}(in.Validate)
+	out["needs_apply"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.NeedsApply)
+	out["needs_rolling_update"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.NeedsRollingUpdate)
 }
 
 func FlattenResourceClusterUpdater(in resources.ClusterUpdater) map[string]interface{} {
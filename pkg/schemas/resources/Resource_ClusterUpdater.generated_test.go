@@ -31,6 +31,8 @@ func TestExpandResourceClusterUpdater(t *testing.T) {
 					"validate": func() []interface{} {
 						return []interface{}{FlattenResourceValidateOptions(resources.ValidateOptions{})}
 					}(),
+					"needs_apply":          false,
+					"needs_rolling_update": false,
 				},
 			},
 			want: _default,
@@ -58,6 +60,8 @@ func TestFlattenResourceClusterUpdaterInto(t *testing.T) {
 		"validate": func() []interface{} {
 			return []interface{}{FlattenResourceValidateOptions(resources.ValidateOptions{})}
 		}(),
+		"needs_apply":          false,
+		"needs_rolling_update": false,
 	}
 	type args struct {
 		in resources.ClusterUpdater
@@ -140,6 +144,28 @@ func TestFlattenResourceClusterUpdaterInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "NeedsApply - default",
+			args: args{
+				in: func() resources.ClusterUpdater {
+					subject := resources.ClusterUpdater{}
+					subject.NeedsApply = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsRollingUpdate - default",
+			args: args{
+				in: func() resources.ClusterUpdater {
+					subject := resources.ClusterUpdater{}
+					subject.NeedsRollingUpdate = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,6 +190,8 @@ func TestFlattenResourceClusterUpdater(t *testing.T) {
 		"validate": func() []interface{} {
 			return []interface{}{FlattenResourceValidateOptions(resources.ValidateOptions{})}
 		}(),
+		"needs_apply":          false,
+		"needs_rolling_update": false,
 	}
 	type args struct {
 		in resources.ClusterUpdater
@@ -246,6 +274,28 @@ func TestFlattenResourceClusterUpdater(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "NeedsApply - default",
+			args: args{
+				in: func() resources.ClusterUpdater {
+					subject := resources.ClusterUpdater{}
+					subject.NeedsApply = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsRollingUpdate - default",
+			args: args{
+				in: func() resources.ClusterUpdater {
+					subject := resources.ClusterUpdater{}
+					subject.NeedsRollingUpdate = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
 	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
 	kopsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/kops"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/kops/pkg/apis/kops"
 )
@@ -63,6 +65,9 @@ func ResourceInstanceGroup() *schema.Resource {
 			"revision":                          ComputedInt(),
 			"cluster_name":                      ForceNew(RequiredString()),
 			"name":                              ForceNew(RequiredString()),
+			"deletion_protection":               OptionalBool(),
+			"delete_mode":                       StringIn([]string{"destroy", "state_store_only", "orphan"}, OptionalString()),
+			"delete":                            OptionalStruct(utilsschemas.ResourceInstanceGroupDeleteOptions()),
 		},
 	}
 	res.SchemaVersion = 2
@@ -105,6 +110,20 @@ func ExpandResourceInstanceGroup(in map[string]interface{}) resources.InstanceGr
 		Name: func(in interface{}) string {
 			return string(ExpandString(in))
 		}(in["name"]),
+		DeletionProtection: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["deletion_protection"]),
+		DeleteMode: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["delete_mode"]),
+		Delete: func(in interface{}) utils.InstanceGroupDeleteOptions {
+			return func(in interface{}) utils.InstanceGroupDeleteOptions {
+				if len(in.([]interface{})) == 0 || in.([]interface{})[0] == nil {
+					return utils.InstanceGroupDeleteOptions{}
+				}
+				return (utilsschemas.ExpandResourceInstanceGroupDeleteOptions(in.([]interface{})[0].(map[string]interface{})))
+			}(in)
+		}(in["delete"]),
 	}
 }
 
@@ -119,6 +138,17 @@ func FlattenResourceInstanceGroupInto(in resources.InstanceGroup, out map[string
 	out["name"] = func(in string) interface{} {
 		return FlattenString(string(in))
 	}(in.Name)
+	out["deletion_protection"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.DeletionProtection)
+	out["delete_mode"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.DeleteMode)
+	out["delete"] = func(in utils.InstanceGroupDeleteOptions) interface{} {
+		return func(in utils.InstanceGroupDeleteOptions) []interface{} {
+			return []interface{}{utilsschemas.FlattenResourceInstanceGroupDeleteOptions(in)}
+		}(in)
+	}(in.Delete)
 }
 
 func FlattenResourceInstanceGroup(in resources.InstanceGroup) map[string]interface{} {
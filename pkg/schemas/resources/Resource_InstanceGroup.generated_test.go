@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	utilsschemas "github.com/eddycharly/terraform-provider-kops/pkg/schemas/utils"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -69,6 +71,11 @@ func TestExpandResourceInstanceGroup(t *testing.T) {
 					"revision":                          0,
 					"cluster_name":                      "",
 					"name":                              "",
+					"deletion_protection":               false,
+					"delete_mode":                       "",
+					"delete": func() []interface{} {
+						return []interface{}{utilsschemas.FlattenResourceInstanceGroupDeleteOptions(utils.InstanceGroupDeleteOptions{})}
+					}(),
 				},
 			},
 			want: _default,
@@ -134,6 +141,11 @@ func TestFlattenResourceInstanceGroupInto(t *testing.T) {
 		"revision":                          0,
 		"cluster_name":                      "",
 		"name":                              "",
+		"deletion_protection":               false,
+		"delete_mode":                       "",
+		"delete": func() []interface{} {
+			return []interface{}{utilsschemas.FlattenResourceInstanceGroupDeleteOptions(utils.InstanceGroupDeleteOptions{})}
+		}(),
 	}
 	type args struct {
 		in resources.InstanceGroup
@@ -678,6 +690,39 @@ func TestFlattenResourceInstanceGroupInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DeleteMode - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeleteMode = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Delete - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.Delete = utils.InstanceGroupDeleteOptions{}
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -740,6 +785,11 @@ func TestFlattenResourceInstanceGroup(t *testing.T) {
 		"revision":                          0,
 		"cluster_name":                      "",
 		"name":                              "",
+		"deletion_protection":               false,
+		"delete_mode":                       "",
+		"delete": func() []interface{} {
+			return []interface{}{utilsschemas.FlattenResourceInstanceGroupDeleteOptions(utils.InstanceGroupDeleteOptions{})}
+		}(),
 	}
 	type args struct {
 		in resources.InstanceGroup
@@ -1284,6 +1334,39 @@ func TestFlattenResourceInstanceGroup(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "DeletionProtection - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeletionProtection = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DeleteMode - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.DeleteMode = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Delete - default",
+			args: args{
+				in: func() resources.InstanceGroup {
+					subject := resources.InstanceGroup{}
+					subject.Delete = utils.InstanceGroupDeleteOptions{}
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,135 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ResourceKeypair() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"revision":     ComputedInt(),
+			"cluster_name": ForceNew(RequiredString()),
+			"name":         ForceNew(RequiredString()),
+			"cert":         RequiredString(),
+			"key":          Sensitive(RequiredString()),
+			"staged_cert":  OptionalString(),
+			"distrust":     OptionalList(String()),
+			"primary_id":   ComputedString(),
+			"keys":         ComputedList(ResourceKeysetItem()),
+		},
+	}
+
+	return res
+}
+
+func ExpandResourceKeypair(in map[string]interface{}) resources.Keypair {
+	if in == nil {
+		panic("expand Keypair failure, in is nil")
+	}
+	return resources.Keypair{
+		Revision: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["revision"]),
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Cert: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cert"]),
+		Key: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["key"]),
+		StagedCert: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["staged_cert"]),
+		Distrust: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["distrust"]),
+		PrimaryId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["primary_id"]),
+		Keys: func(in interface{}) []resources.KeysetItem {
+			return func(in interface{}) []resources.KeysetItem {
+				if in == nil {
+					return nil
+				}
+				var out []resources.KeysetItem
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) resources.KeysetItem {
+						if in == nil {
+							return resources.KeysetItem{}
+						}
+						return (ExpandResourceKeysetItem(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["keys"]),
+	}
+}
+
+func FlattenResourceKeypairInto(in resources.Keypair, out map[string]interface{}) {
+	out["revision"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.Revision)
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["cert"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Cert)
+	out["key"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Key)
+	out["staged_cert"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.StagedCert)
+	out["distrust"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.Distrust)
+	out["primary_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.PrimaryId)
+	out["keys"] = func(in []resources.KeysetItem) interface{} {
+		return func(in []resources.KeysetItem) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in resources.KeysetItem) interface{} {
+					return FlattenResourceKeysetItem(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Keys)
+}
+
+func FlattenResourceKeypair(in resources.Keypair) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenResourceKeypairInto(in, out)
+	return out
+}
@@ -0,0 +1,321 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandResourceKeypair(t *testing.T) {
+	_default := resources.Keypair{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want resources.Keypair
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"revision":     0,
+					"cluster_name": "",
+					"name":         "",
+					"cert":         "",
+					"key":          "",
+					"staged_cert":  "",
+					"distrust":     func() []interface{} { return nil }(),
+					"primary_id":   "",
+					"keys":         func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandResourceKeypair(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandResourceKeypair() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceKeypairInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"revision":     0,
+		"cluster_name": "",
+		"name":         "",
+		"cert":         "",
+		"key":          "",
+		"staged_cert":  "",
+		"distrust":     func() []interface{} { return nil }(),
+		"primary_id":   "",
+		"keys":         func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in resources.Keypair
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.Keypair{},
+			},
+			want: _default,
+		},
+		{
+			name: "Revision - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Revision = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Cert - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Cert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Key - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Key = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StagedCert - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.StagedCert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Distrust - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Distrust = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PrimaryId - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.PrimaryId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Keys - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Keys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenResourceKeypairInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceKeypair() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceKeypair(t *testing.T) {
+	_default := map[string]interface{}{
+		"revision":     0,
+		"cluster_name": "",
+		"name":         "",
+		"cert":         "",
+		"key":          "",
+		"staged_cert":  "",
+		"distrust":     func() []interface{} { return nil }(),
+		"primary_id":   "",
+		"keys":         func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in resources.Keypair
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.Keypair{},
+			},
+			want: _default,
+		},
+		{
+			name: "Revision - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Revision = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Cert - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Cert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Key - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Key = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StagedCert - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.StagedCert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Distrust - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Distrust = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PrimaryId - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.PrimaryId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Keys - default",
+			args: args{
+				in: func() resources.Keypair {
+					subject := resources.Keypair{}
+					subject.Keys = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenResourceKeypair(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceKeypair() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ResourceKeysetItem() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":      ComputedString(),
+			"cert":    ComputedString(),
+			"primary": ComputedBool(),
+		},
+	}
+
+	return res
+}
+
+func ExpandResourceKeysetItem(in map[string]interface{}) resources.KeysetItem {
+	if in == nil {
+		panic("expand KeysetItem failure, in is nil")
+	}
+	return resources.KeysetItem{
+		Id: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["id"]),
+		Cert: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cert"]),
+		Primary: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["primary"]),
+	}
+}
+
+func FlattenResourceKeysetItemInto(in resources.KeysetItem, out map[string]interface{}) {
+	out["id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Id)
+	out["cert"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Cert)
+	out["primary"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.Primary)
+}
+
+func FlattenResourceKeysetItem(in resources.KeysetItem) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenResourceKeysetItemInto(in, out)
+	return out
+}
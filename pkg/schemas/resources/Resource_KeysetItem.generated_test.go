@@ -0,0 +1,171 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandResourceKeysetItem(t *testing.T) {
+	_default := resources.KeysetItem{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want resources.KeysetItem
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"id":      "",
+					"cert":    "",
+					"primary": false,
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandResourceKeysetItem(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandResourceKeysetItem() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceKeysetItemInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":      "",
+		"cert":    "",
+		"primary": false,
+	}
+	type args struct {
+		in resources.KeysetItem
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.KeysetItem{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Cert - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Cert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Primary - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Primary = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenResourceKeysetItemInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceKeysetItem() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceKeysetItem(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":      "",
+		"cert":    "",
+		"primary": false,
+	}
+	type args struct {
+		in resources.KeysetItem
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.KeysetItem{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Cert - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Cert = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Primary - default",
+			args: args{
+				in: func() resources.KeysetItem {
+					subject := resources.KeysetItem{}
+					subject.Primary = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenResourceKeysetItem(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceKeysetItem() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
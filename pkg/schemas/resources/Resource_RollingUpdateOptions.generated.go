@@ -13,17 +13,19 @@ var _ = Schema
 func ResourceRollingUpdateOptions() *schema.Resource {
 	res := &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			"skip":                OptionalBool(),
-			"master_interval":     OptionalDuration(),
-			"node_interval":       OptionalDuration(),
-			"bastion_interval":    OptionalDuration(),
-			"fail_on_drain_error": OptionalBool(),
-			"fail_on_validate":    OptionalBool(),
-			"post_drain_delay":    OptionalDuration(),
-			"validation_timeout":  OptionalDuration(),
-			"validate_count":      OptionalInt(),
-			"cloud_only":          OptionalBool(),
-			"force":               OptionalBool(),
+			"skip":                 OptionalBool(),
+			"master_interval":      OptionalDuration(),
+			"node_interval":        OptionalDuration(),
+			"bastion_interval":     OptionalDuration(),
+			"fail_on_drain_error":  OptionalBool(),
+			"fail_on_validate":     OptionalBool(),
+			"post_drain_delay":     OptionalDuration(),
+			"validation_timeout":   OptionalDuration(),
+			"validate_count":       OptionalInt(),
+			"cloud_only":           OptionalBool(),
+			"force":                OptionalBool(),
+			"instance_groups":      OptionalList(String()),
+			"instance_group_roles": OptionalList(String()),
 		},
 	}
 
@@ -21,17 +21,19 @@ func TestExpandResourceRollingUpdateOptions(t *testing.T) {
 			name: "default",
 			args: args{
 				in: map[string]interface{}{
-					"skip":                false,
-					"master_interval":     nil,
-					"node_interval":       nil,
-					"bastion_interval":    nil,
-					"fail_on_drain_error": false,
-					"fail_on_validate":    false,
-					"post_drain_delay":    nil,
-					"validation_timeout":  nil,
-					"validate_count":      nil,
-					"cloud_only":          false,
-					"force":               false,
+					"skip":                 false,
+					"master_interval":      nil,
+					"node_interval":        nil,
+					"bastion_interval":     nil,
+					"fail_on_drain_error":  false,
+					"fail_on_validate":     false,
+					"post_drain_delay":     nil,
+					"validation_timeout":   nil,
+					"validate_count":       nil,
+					"cloud_only":           false,
+					"force":                false,
+					"instance_groups":      func() []interface{} { return nil }(),
+					"instance_group_roles": func() []interface{} { return nil }(),
 				},
 			},
 			want: _default,
@@ -49,17 +51,19 @@ func TestExpandResourceRollingUpdateOptions(t *testing.T) {
 
 func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 	_default := map[string]interface{}{
-		"skip":                false,
-		"master_interval":     nil,
-		"node_interval":       nil,
-		"bastion_interval":    nil,
-		"fail_on_drain_error": false,
-		"fail_on_validate":    false,
-		"post_drain_delay":    nil,
-		"validation_timeout":  nil,
-		"validate_count":      nil,
-		"cloud_only":          false,
-		"force":               false,
+		"skip":                 false,
+		"master_interval":      nil,
+		"node_interval":        nil,
+		"bastion_interval":     nil,
+		"fail_on_drain_error":  false,
+		"fail_on_validate":     false,
+		"post_drain_delay":     nil,
+		"validation_timeout":   nil,
+		"validate_count":       nil,
+		"cloud_only":           false,
+		"force":                false,
+		"instance_groups":      func() []interface{} { return nil }(),
+		"instance_group_roles": func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in resources.RollingUpdateOptions
@@ -197,6 +201,28 @@ func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() resources.RollingUpdateOptions {
+					subject := resources.RollingUpdateOptions{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() resources.RollingUpdateOptions {
+					subject := resources.RollingUpdateOptions{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -211,17 +237,19 @@ func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 
 func TestFlattenResourceRollingUpdateOptions(t *testing.T) {
 	_default := map[string]interface{}{
-		"skip":                false,
-		"master_interval":     nil,
-		"node_interval":       nil,
-		"bastion_interval":    nil,
-		"fail_on_drain_error": false,
-		"fail_on_validate":    false,
-		"post_drain_delay":    nil,
-		"validation_timeout":  nil,
-		"validate_count":      nil,
-		"cloud_only":          false,
-		"force":               false,
+		"skip":                 false,
+		"master_interval":      nil,
+		"node_interval":        nil,
+		"bastion_interval":     nil,
+		"fail_on_drain_error":  false,
+		"fail_on_validate":     false,
+		"post_drain_delay":     nil,
+		"validation_timeout":   nil,
+		"validate_count":       nil,
+		"cloud_only":           false,
+		"force":                false,
+		"instance_groups":      func() []interface{} { return nil }(),
+		"instance_group_roles": func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in resources.RollingUpdateOptions
@@ -359,6 +387,28 @@ func TestFlattenResourceRollingUpdateOptions(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() resources.RollingUpdateOptions {
+					subject := resources.RollingUpdateOptions{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() resources.RollingUpdateOptions {
+					subject := resources.RollingUpdateOptions{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
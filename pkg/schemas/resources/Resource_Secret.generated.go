@@ -0,0 +1,70 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func ResourceSecret() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"revision":     ComputedInt(),
+			"cluster_name": ForceNew(RequiredString()),
+			"name":         ForceNew(RequiredString()),
+			"data":         Sensitive(OptionalString()),
+			"data_base64":  Sensitive(OptionalString()),
+		},
+	}
+
+	return res
+}
+
+func ExpandResourceSecret(in map[string]interface{}) resources.Secret {
+	if in == nil {
+		panic("expand Secret failure, in is nil")
+	}
+	return resources.Secret{
+		Revision: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["revision"]),
+		ClusterName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cluster_name"]),
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Data: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["data"]),
+		DataBase64: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["data_base64"]),
+	}
+}
+
+func FlattenResourceSecretInto(in resources.Secret, out map[string]interface{}) {
+	out["revision"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.Revision)
+	out["cluster_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.ClusterName)
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["data"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Data)
+	out["data_base64"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.DataBase64)
+}
+
+func FlattenResourceSecret(in resources.Secret) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenResourceSecretInto(in, out)
+	return out
+}
@@ -0,0 +1,221 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/resources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandResourceSecret(t *testing.T) {
+	_default := resources.Secret{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want resources.Secret
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"revision":     0,
+					"cluster_name": "",
+					"name":         "",
+					"data":         "",
+					"data_base64":  "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandResourceSecret(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandResourceSecret() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceSecretInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"revision":     0,
+		"cluster_name": "",
+		"name":         "",
+		"data":         "",
+		"data_base64":  "",
+	}
+	type args struct {
+		in resources.Secret
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.Secret{},
+			},
+			want: _default,
+		},
+		{
+			name: "Revision - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Revision = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Data - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Data = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DataBase64 - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.DataBase64 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenResourceSecretInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceSecret() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceSecret(t *testing.T) {
+	_default := map[string]interface{}{
+		"revision":     0,
+		"cluster_name": "",
+		"name":         "",
+		"data":         "",
+		"data_base64":  "",
+	}
+	type args struct {
+		in resources.Secret
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: resources.Secret{},
+			},
+			want: _default,
+		},
+		{
+			name: "Revision - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Revision = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ClusterName - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.ClusterName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Data - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.Data = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "DataBase64 - default",
+			args: args{
+				in: func() resources.Secret {
+					subject := resources.Secret{}
+					subject.DataBase64 = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenResourceSecret(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceSecret() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
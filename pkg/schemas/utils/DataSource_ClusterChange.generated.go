@@ -0,0 +1,85 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceClusterChange() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action": ComputedString(),
+			"type":   ComputedString(),
+			"name":   ComputedString(),
+			"fields": ComputedList(DataSourceClusterChangeField()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceClusterChange(in map[string]interface{}) utils.ClusterChange {
+	if in == nil {
+		panic("expand ClusterChange failure, in is nil")
+	}
+	return utils.ClusterChange{
+		Action: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["action"]),
+		Type: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["type"]),
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Fields: func(in interface{}) []utils.ClusterChangeField {
+			return func(in interface{}) []utils.ClusterChangeField {
+				if in == nil {
+					return nil
+				}
+				var out []utils.ClusterChangeField
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.ClusterChangeField {
+						if in == nil {
+							return utils.ClusterChangeField{}
+						}
+						return (ExpandDataSourceClusterChangeField(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["fields"]),
+	}
+}
+
+func FlattenDataSourceClusterChangeInto(in utils.ClusterChange, out map[string]interface{}) {
+	out["action"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Action)
+	out["type"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Type)
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["fields"] = func(in []utils.ClusterChangeField) interface{} {
+		return func(in []utils.ClusterChangeField) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.ClusterChangeField) interface{} {
+					return FlattenDataSourceClusterChangeField(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Fields)
+}
+
+func FlattenDataSourceClusterChange(in utils.ClusterChange) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceClusterChangeInto(in, out)
+	return out
+}
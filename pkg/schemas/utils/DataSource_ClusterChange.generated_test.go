@@ -0,0 +1,196 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceClusterChange(t *testing.T) {
+	_default := utils.ClusterChange{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.ClusterChange
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"action": "",
+					"type":   "",
+					"name":   "",
+					"fields": func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceClusterChange(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceClusterChange() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChangeInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"action": "",
+		"type":   "",
+		"name":   "",
+		"fields": func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in utils.ClusterChange
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ClusterChange{},
+			},
+			want: _default,
+		},
+		{
+			name: "Action - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Action = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Type - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Type = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Fields - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Fields = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceClusterChangeInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChange() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChange(t *testing.T) {
+	_default := map[string]interface{}{
+		"action": "",
+		"type":   "",
+		"name":   "",
+		"fields": func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in utils.ClusterChange
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ClusterChange{},
+			},
+			want: _default,
+		},
+		{
+			name: "Action - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Action = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Type - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Type = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Fields - default",
+			args: args{
+				in: func() utils.ClusterChange {
+					subject := utils.ClusterChange{}
+					subject.Fields = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceClusterChange(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChange() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
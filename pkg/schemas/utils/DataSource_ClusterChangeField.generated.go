@@ -0,0 +1,63 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceClusterChangeField() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": ComputedString(),
+			"old":  ComputedString(),
+			"new":  ComputedString(),
+			"diff": ComputedString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceClusterChangeField(in map[string]interface{}) utils.ClusterChangeField {
+	if in == nil {
+		panic("expand ClusterChangeField failure, in is nil")
+	}
+	return utils.ClusterChangeField{
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Old: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["old"]),
+		New: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["new"]),
+		Diff: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["diff"]),
+	}
+}
+
+func FlattenDataSourceClusterChangeFieldInto(in utils.ClusterChangeField, out map[string]interface{}) {
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["old"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Old)
+	out["new"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.New)
+	out["diff"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Diff)
+}
+
+func FlattenDataSourceClusterChangeField(in utils.ClusterChangeField) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceClusterChangeFieldInto(in, out)
+	return out
+}
@@ -0,0 +1,196 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceClusterChangeField(t *testing.T) {
+	_default := utils.ClusterChangeField{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.ClusterChangeField
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"name": "",
+					"old":  "",
+					"new":  "",
+					"diff": "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceClusterChangeField(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceClusterChangeField() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChangeFieldInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"name": "",
+		"old":  "",
+		"new":  "",
+		"diff": "",
+	}
+	type args struct {
+		in utils.ClusterChangeField
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ClusterChangeField{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Old - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Old = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "New - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.New = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Diff - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Diff = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceClusterChangeFieldInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChangeField() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceClusterChangeField(t *testing.T) {
+	_default := map[string]interface{}{
+		"name": "",
+		"old":  "",
+		"new":  "",
+		"diff": "",
+	}
+	type args struct {
+		in utils.ClusterChangeField
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ClusterChangeField{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Old - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Old = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "New - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.New = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Diff - default",
+			args: args{
+				in: func() utils.ClusterChangeField {
+					subject := utils.ClusterChangeField{}
+					subject.Diff = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceClusterChangeField(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceClusterChangeField() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstance() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":             ComputedString(),
+			"instance_group": ComputedString(),
+			"role":           ComputedString(),
+			"machine_type":   ComputedString(),
+			"private_ip":     ComputedString(),
+			"node_name":      ComputedString(),
+			"status":         ComputedString(),
+			"needs_update":   ComputedBool(),
+			"update_reason":  ComputedString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceInstance(in map[string]interface{}) utils.Instance {
+	if in == nil {
+		panic("expand Instance failure, in is nil")
+	}
+	return utils.Instance{
+		Id: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["id"]),
+		InstanceGroup: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["instance_group"]),
+		Role: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["role"]),
+		MachineType: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["machine_type"]),
+		PrivateIp: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["private_ip"]),
+		NodeName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["node_name"]),
+		Status: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["status"]),
+		NeedsUpdate: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["needs_update"]),
+		UpdateReason: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["update_reason"]),
+	}
+}
+
+func FlattenDataSourceInstanceInto(in utils.Instance, out map[string]interface{}) {
+	out["id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Id)
+	out["instance_group"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.InstanceGroup)
+	out["role"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Role)
+	out["machine_type"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.MachineType)
+	out["private_ip"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.PrivateIp)
+	out["node_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.NodeName)
+	out["status"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Status)
+	out["needs_update"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.NeedsUpdate)
+	out["update_reason"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.UpdateReason)
+}
+
+func FlattenDataSourceInstance(in utils.Instance) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstanceInto(in, out)
+	return out
+}
@@ -0,0 +1,321 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceInstance(t *testing.T) {
+	_default := utils.Instance{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.Instance
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"id":             "",
+					"instance_group": "",
+					"role":           "",
+					"machine_type":   "",
+					"private_ip":     "",
+					"node_name":      "",
+					"status":         "",
+					"needs_update":   false,
+					"update_reason":  "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceInstance(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":             "",
+		"instance_group": "",
+		"role":           "",
+		"machine_type":   "",
+		"private_ip":     "",
+		"node_name":      "",
+		"status":         "",
+		"needs_update":   false,
+		"update_reason":  "",
+	}
+	type args struct {
+		in utils.Instance
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.Instance{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroup - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.InstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Role - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Role = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "MachineType - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.MachineType = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PrivateIp - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.PrivateIp = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NodeName - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.NodeName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsUpdate - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.NeedsUpdate = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "UpdateReason - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.UpdateReason = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceInstanceInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstance(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":             "",
+		"instance_group": "",
+		"role":           "",
+		"machine_type":   "",
+		"private_ip":     "",
+		"node_name":      "",
+		"status":         "",
+		"needs_update":   false,
+		"update_reason":  "",
+	}
+	type args struct {
+		in utils.Instance
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.Instance{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroup - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.InstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Role - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Role = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "MachineType - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.MachineType = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PrivateIp - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.PrivateIp = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NodeName - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.NodeName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedsUpdate - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.NeedsUpdate = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "UpdateReason - default",
+			args: args{
+				in: func() utils.Instance {
+					subject := utils.Instance{}
+					subject.UpdateReason = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceInstance(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
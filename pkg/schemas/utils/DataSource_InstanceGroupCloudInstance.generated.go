@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstanceGroupCloudInstance() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":        ComputedString(),
+			"status":    ComputedString(),
+			"node_name": ComputedString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceInstanceGroupCloudInstance(in map[string]interface{}) utils.InstanceGroupCloudInstance {
+	if in == nil {
+		panic("expand InstanceGroupCloudInstance failure, in is nil")
+	}
+	return utils.InstanceGroupCloudInstance{
+		Id: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["id"]),
+		Status: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["status"]),
+		NodeName: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["node_name"]),
+	}
+}
+
+func FlattenDataSourceInstanceGroupCloudInstanceInto(in utils.InstanceGroupCloudInstance, out map[string]interface{}) {
+	out["id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Id)
+	out["status"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Status)
+	out["node_name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.NodeName)
+}
+
+func FlattenDataSourceInstanceGroupCloudInstance(in utils.InstanceGroupCloudInstance) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstanceGroupCloudInstanceInto(in, out)
+	return out
+}
@@ -0,0 +1,171 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceInstanceGroupCloudInstance(t *testing.T) {
+	_default := utils.InstanceGroupCloudInstance{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.InstanceGroupCloudInstance
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"id":        "",
+					"status":    "",
+					"node_name": "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceInstanceGroupCloudInstance(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceInstanceGroupCloudInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroupCloudInstanceInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":        "",
+		"status":    "",
+		"node_name": "",
+	}
+	type args struct {
+		in utils.InstanceGroupCloudInstance
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupCloudInstance{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NodeName - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.NodeName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceInstanceGroupCloudInstanceInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroupCloudInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroupCloudInstance(t *testing.T) {
+	_default := map[string]interface{}{
+		"id":        "",
+		"status":    "",
+		"node_name": "",
+	}
+	type args struct {
+		in utils.InstanceGroupCloudInstance
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupCloudInstance{},
+			},
+			want: _default,
+		},
+		{
+			name: "Id - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.Id = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NodeName - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudInstance {
+					subject := utils.InstanceGroupCloudInstance{}
+					subject.NodeName = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceInstanceGroupCloudInstance(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroupCloudInstance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceInstanceGroupCloudStatus() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"missing":           ComputedBool(),
+			"cloud_group_id":    ComputedString(),
+			"target_size":       ComputedInt(),
+			"ready_count":       ComputedInt(),
+			"need_update_count": ComputedInt(),
+			"instances":         ComputedList(DataSourceInstanceGroupCloudInstance()),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceInstanceGroupCloudStatus(in map[string]interface{}) utils.InstanceGroupCloudStatus {
+	if in == nil {
+		panic("expand InstanceGroupCloudStatus failure, in is nil")
+	}
+	return utils.InstanceGroupCloudStatus{
+		Missing: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["missing"]),
+		CloudGroupId: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["cloud_group_id"]),
+		TargetSize: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["target_size"]),
+		ReadyCount: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["ready_count"]),
+		NeedUpdateCount: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["need_update_count"]),
+		Instances: func(in interface{}) []utils.InstanceGroupCloudInstance {
+			return func(in interface{}) []utils.InstanceGroupCloudInstance {
+				if in == nil {
+					return nil
+				}
+				var out []utils.InstanceGroupCloudInstance
+				for _, in := range in.([]interface{}) {
+					out = append(out, func(in interface{}) utils.InstanceGroupCloudInstance {
+						if in == nil {
+							return utils.InstanceGroupCloudInstance{}
+						}
+						return (ExpandDataSourceInstanceGroupCloudInstance(in.(map[string]interface{})))
+					}(in))
+				}
+				return out
+			}(in)
+		}(in["instances"]),
+	}
+}
+
+func FlattenDataSourceInstanceGroupCloudStatusInto(in utils.InstanceGroupCloudStatus, out map[string]interface{}) {
+	out["missing"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.Missing)
+	out["cloud_group_id"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.CloudGroupId)
+	out["target_size"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.TargetSize)
+	out["ready_count"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.ReadyCount)
+	out["need_update_count"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.NeedUpdateCount)
+	out["instances"] = func(in []utils.InstanceGroupCloudInstance) interface{} {
+		return func(in []utils.InstanceGroupCloudInstance) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, func(in utils.InstanceGroupCloudInstance) interface{} {
+					return FlattenDataSourceInstanceGroupCloudInstance(in)
+				}(in))
+			}
+			return out
+		}(in)
+	}(in.Instances)
+}
+
+func FlattenDataSourceInstanceGroupCloudStatus(in utils.InstanceGroupCloudStatus) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceInstanceGroupCloudStatusInto(in, out)
+	return out
+}
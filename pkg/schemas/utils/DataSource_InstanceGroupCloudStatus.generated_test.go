@@ -0,0 +1,246 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceInstanceGroupCloudStatus(t *testing.T) {
+	_default := utils.InstanceGroupCloudStatus{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.InstanceGroupCloudStatus
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"missing":           false,
+					"cloud_group_id":    "",
+					"target_size":       0,
+					"ready_count":       0,
+					"need_update_count": 0,
+					"instances":         func() []interface{} { return nil }(),
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceInstanceGroupCloudStatus(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceInstanceGroupCloudStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroupCloudStatusInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"missing":           false,
+		"cloud_group_id":    "",
+		"target_size":       0,
+		"ready_count":       0,
+		"need_update_count": 0,
+		"instances":         func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in utils.InstanceGroupCloudStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupCloudStatus{},
+			},
+			want: _default,
+		},
+		{
+			name: "Missing - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.Missing = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CloudGroupId - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.CloudGroupId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "TargetSize - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.TargetSize = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReadyCount - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.ReadyCount = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedUpdateCount - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.NeedUpdateCount = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Instances - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.Instances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceInstanceGroupCloudStatusInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroupCloudStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceInstanceGroupCloudStatus(t *testing.T) {
+	_default := map[string]interface{}{
+		"missing":           false,
+		"cloud_group_id":    "",
+		"target_size":       0,
+		"ready_count":       0,
+		"need_update_count": 0,
+		"instances":         func() []interface{} { return nil }(),
+	}
+	type args struct {
+		in utils.InstanceGroupCloudStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupCloudStatus{},
+			},
+			want: _default,
+		},
+		{
+			name: "Missing - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.Missing = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CloudGroupId - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.CloudGroupId = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "TargetSize - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.TargetSize = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReadyCount - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.ReadyCount = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "NeedUpdateCount - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.NeedUpdateCount = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Instances - default",
+			args: args{
+				in: func() utils.InstanceGroupCloudStatus {
+					subject := utils.InstanceGroupCloudStatus{}
+					subject.Instances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceInstanceGroupCloudStatus(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceInstanceGroupCloudStatus() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+)
+
+var _ = Schema
+
+func ExpandDataSourceRollingUpdateProgress(in map[string]interface{}) utils.RollingUpdateProgress {
+	if in == nil {
+		panic("expand RollingUpdateProgress failure, in is nil")
+	}
+	return utils.RollingUpdateProgress{
+		Status: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["status"]),
+		StartedAt: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["started_at"]),
+		FinishedAt: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["finished_at"]),
+		Resumes: func(in interface{}) int {
+			return int(ExpandInt(in))
+		}(in["resumes"]),
+		InstanceGroups: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["instance_groups"]),
+		InstanceGroupRoles: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["instance_group_roles"]),
+		CompletedInstanceGroups: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["completed_instance_groups"]),
+		CurrentInstanceGroup: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["current_instance_group"]),
+		PendingInstances: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["pending_instances"]),
+		ReplacedInstances: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["replaced_instances"]),
+		LastError: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["last_error"]),
+	}
+}
+
+func FlattenDataSourceRollingUpdateProgressInto(in utils.RollingUpdateProgress, out map[string]interface{}) {
+	out["status"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Status)
+	out["started_at"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.StartedAt)
+	out["finished_at"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.FinishedAt)
+	out["resumes"] = func(in int) interface{} {
+		return FlattenInt(int(in))
+	}(in.Resumes)
+	out["instance_groups"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroups)
+	out["instance_group_roles"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroupRoles)
+	out["completed_instance_groups"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.CompletedInstanceGroups)
+	out["current_instance_group"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.CurrentInstanceGroup)
+	out["pending_instances"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.PendingInstances)
+	out["replaced_instances"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.ReplacedInstances)
+	out["last_error"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.LastError)
+}
+
+func FlattenDataSourceRollingUpdateProgress(in utils.RollingUpdateProgress) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceRollingUpdateProgressInto(in, out)
+	return out
+}
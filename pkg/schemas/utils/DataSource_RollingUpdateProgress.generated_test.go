@@ -0,0 +1,371 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceRollingUpdateProgress(t *testing.T) {
+	_default := utils.RollingUpdateProgress{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.RollingUpdateProgress
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"status":                    "",
+					"started_at":                "",
+					"finished_at":               "",
+					"resumes":                   0,
+					"instance_groups":           func() []interface{} { return nil }(),
+					"instance_group_roles":      func() []interface{} { return nil }(),
+					"completed_instance_groups": func() []interface{} { return nil }(),
+					"current_instance_group":    "",
+					"pending_instances":         func() []interface{} { return nil }(),
+					"replaced_instances":        func() []interface{} { return nil }(),
+					"last_error":                "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceRollingUpdateProgress(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceRollingUpdateProgress() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceRollingUpdateProgressInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"status":                    "",
+		"started_at":                "",
+		"finished_at":               "",
+		"resumes":                   0,
+		"instance_groups":           func() []interface{} { return nil }(),
+		"instance_group_roles":      func() []interface{} { return nil }(),
+		"completed_instance_groups": func() []interface{} { return nil }(),
+		"current_instance_group":    "",
+		"pending_instances":         func() []interface{} { return nil }(),
+		"replaced_instances":        func() []interface{} { return nil }(),
+		"last_error":                "",
+	}
+	type args struct {
+		in utils.RollingUpdateProgress
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.RollingUpdateProgress{},
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StartedAt - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.StartedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FinishedAt - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.FinishedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Resumes - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.Resumes = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CompletedInstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.CompletedInstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CurrentInstanceGroup - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.CurrentInstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PendingInstances - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.PendingInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReplacedInstances - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.ReplacedInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "LastError - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.LastError = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceRollingUpdateProgressInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceRollingUpdateProgress() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceRollingUpdateProgress(t *testing.T) {
+	_default := map[string]interface{}{
+		"status":                    "",
+		"started_at":                "",
+		"finished_at":               "",
+		"resumes":                   0,
+		"instance_groups":           func() []interface{} { return nil }(),
+		"instance_group_roles":      func() []interface{} { return nil }(),
+		"completed_instance_groups": func() []interface{} { return nil }(),
+		"current_instance_group":    "",
+		"pending_instances":         func() []interface{} { return nil }(),
+		"replaced_instances":        func() []interface{} { return nil }(),
+		"last_error":                "",
+	}
+	type args struct {
+		in utils.RollingUpdateProgress
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.RollingUpdateProgress{},
+			},
+			want: _default,
+		},
+		{
+			name: "Status - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.Status = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "StartedAt - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.StartedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FinishedAt - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.FinishedAt = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Resumes - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.Resumes = 0
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CompletedInstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.CompletedInstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "CurrentInstanceGroup - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.CurrentInstanceGroup = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PendingInstances - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.PendingInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "ReplacedInstances - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.ReplacedInstances = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "LastError - default",
+			args: args{
+				in: func() utils.RollingUpdateProgress {
+					subject := utils.RollingUpdateProgress{}
+					subject.LastError = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceRollingUpdateProgress(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceRollingUpdateProgress() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
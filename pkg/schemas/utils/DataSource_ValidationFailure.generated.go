@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceValidationFailure() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"kind":    ComputedString(),
+			"name":    ComputedString(),
+			"message": ComputedString(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceValidationFailure(in map[string]interface{}) utils.ValidationFailure {
+	if in == nil {
+		panic("expand ValidationFailure failure, in is nil")
+	}
+	return utils.ValidationFailure{
+		Kind: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["kind"]),
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Message: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["message"]),
+	}
+}
+
+func FlattenDataSourceValidationFailureInto(in utils.ValidationFailure, out map[string]interface{}) {
+	out["kind"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Kind)
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["message"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Message)
+}
+
+func FlattenDataSourceValidationFailure(in utils.ValidationFailure) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceValidationFailureInto(in, out)
+	return out
+}
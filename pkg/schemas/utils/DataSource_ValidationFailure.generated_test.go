@@ -0,0 +1,171 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceValidationFailure(t *testing.T) {
+	_default := utils.ValidationFailure{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.ValidationFailure
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"kind":    "",
+					"name":    "",
+					"message": "",
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceValidationFailure(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceValidationFailure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceValidationFailureInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"kind":    "",
+		"name":    "",
+		"message": "",
+	}
+	type args struct {
+		in utils.ValidationFailure
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ValidationFailure{},
+			},
+			want: _default,
+		},
+		{
+			name: "Kind - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Kind = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Message - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Message = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceValidationFailureInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceValidationFailure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceValidationFailure(t *testing.T) {
+	_default := map[string]interface{}{
+		"kind":    "",
+		"name":    "",
+		"message": "",
+	}
+	type args struct {
+		in utils.ValidationFailure
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ValidationFailure{},
+			},
+			want: _default,
+		},
+		{
+			name: "Kind - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Kind = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Message - default",
+			args: args{
+				in: func() utils.ValidationFailure {
+					subject := utils.ValidationFailure{}
+					subject.Message = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceValidationFailure(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceValidationFailure() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
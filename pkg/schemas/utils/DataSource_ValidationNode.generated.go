@@ -0,0 +1,70 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = Schema
+
+func DataSourceValidationNode() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     ComputedString(),
+			"role":     ComputedString(),
+			"zone":     ComputedString(),
+			"hostname": ComputedString(),
+			"ready":    ComputedBool(),
+		},
+	}
+
+	return res
+}
+
+func ExpandDataSourceValidationNode(in map[string]interface{}) utils.ValidationNode {
+	if in == nil {
+		panic("expand ValidationNode failure, in is nil")
+	}
+	return utils.ValidationNode{
+		Name: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["name"]),
+		Role: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["role"]),
+		Zone: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["zone"]),
+		Hostname: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["hostname"]),
+		Ready: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["ready"]),
+	}
+}
+
+func FlattenDataSourceValidationNodeInto(in utils.ValidationNode, out map[string]interface{}) {
+	out["name"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Name)
+	out["role"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Role)
+	out["zone"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Zone)
+	out["hostname"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Hostname)
+	out["ready"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.Ready)
+}
+
+func FlattenDataSourceValidationNode(in utils.ValidationNode) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenDataSourceValidationNodeInto(in, out)
+	return out
+}
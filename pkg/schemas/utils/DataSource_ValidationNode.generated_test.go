@@ -0,0 +1,221 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandDataSourceValidationNode(t *testing.T) {
+	_default := utils.ValidationNode{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.ValidationNode
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"name":     "",
+					"role":     "",
+					"zone":     "",
+					"hostname": "",
+					"ready":    false,
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandDataSourceValidationNode(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandDataSourceValidationNode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceValidationNodeInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"name":     "",
+		"role":     "",
+		"zone":     "",
+		"hostname": "",
+		"ready":    false,
+	}
+	type args struct {
+		in utils.ValidationNode
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ValidationNode{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Role - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Role = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Zone - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Zone = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Hostname - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Hostname = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Ready - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Ready = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenDataSourceValidationNodeInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceValidationNode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDataSourceValidationNode(t *testing.T) {
+	_default := map[string]interface{}{
+		"name":     "",
+		"role":     "",
+		"zone":     "",
+		"hostname": "",
+		"ready":    false,
+	}
+	type args struct {
+		in utils.ValidationNode
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.ValidationNode{},
+			},
+			want: _default,
+		},
+		{
+			name: "Name - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Name = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Role - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Role = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Zone - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Zone = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Hostname - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Hostname = ""
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "Ready - default",
+			args: args{
+				in: func() utils.ValidationNode {
+					subject := utils.ValidationNode{}
+					subject.Ready = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenDataSourceValidationNode(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenDataSourceValidationNode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
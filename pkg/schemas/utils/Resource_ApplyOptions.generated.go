@@ -0,0 +1,66 @@
+package schemas
+
+import (
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+)
+
+var _ = Schema
+
+func ExpandResourceApplyOptions(in map[string]interface{}) utils.ApplyOptions {
+	if in == nil {
+		panic("expand ApplyOptions failure, in is nil")
+	}
+	return utils.ApplyOptions{
+		AllowKopsDowngrade: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["allow_kops_downgrade"]),
+		LifecycleOverrides: func(in interface{}) map[string]string {
+			return func(in interface{}) map[string]string {
+				if in == nil {
+					return nil
+				}
+				if in, ok := in.(map[string]interface{}); ok {
+					if len(in) > 0 {
+						out := map[string]string{}
+						for key, in := range in {
+							out[key] = string(ExpandString(in))
+						}
+						return out
+					}
+				}
+				return nil
+			}(in)
+		}(in["lifecycle_overrides"]),
+		Phase: func(in interface{}) string {
+			return string(ExpandString(in))
+		}(in["phase"]),
+	}
+}
+
+func FlattenResourceApplyOptionsInto(in utils.ApplyOptions, out map[string]interface{}) {
+	out["allow_kops_downgrade"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.AllowKopsDowngrade)
+	out["lifecycle_overrides"] = func(in map[string]string) interface{} {
+		return func(in map[string]string) map[string]interface{} {
+			if in == nil {
+				return nil
+			}
+			out := map[string]interface{}{}
+			for key, in := range in {
+				out[key] = FlattenString(string(in))
+			}
+			return out
+		}(in)
+	}(in.LifecycleOverrides)
+	out["phase"] = func(in string) interface{} {
+		return FlattenString(string(in))
+	}(in.Phase)
+}
+
+func FlattenResourceApplyOptions(in utils.ApplyOptions) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenResourceApplyOptionsInto(in, out)
+	return out
+}
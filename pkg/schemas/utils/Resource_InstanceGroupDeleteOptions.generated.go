@@ -0,0 +1,82 @@
+package schemas
+
+import (
+	"reflect"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	. "github.com/eddycharly/terraform-provider-kops/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Schema
+
+func ResourceInstanceGroupDeleteOptions() *schema.Resource {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"skip_drain":          OptionalBool(),
+			"fail_on_drain_error": OptionalBool(),
+			"post_drain_delay":    OptionalDuration(),
+		},
+	}
+
+	return res
+}
+
+func ExpandResourceInstanceGroupDeleteOptions(in map[string]interface{}) utils.InstanceGroupDeleteOptions {
+	if in == nil {
+		panic("expand InstanceGroupDeleteOptions failure, in is nil")
+	}
+	return utils.InstanceGroupDeleteOptions{
+		SkipDrain: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["skip_drain"]),
+		FailOnDrainError: func(in interface{}) bool {
+			return bool(ExpandBool(in))
+		}(in["fail_on_drain_error"]),
+		PostDrainDelay: func(in interface{}) *v1.Duration {
+			if in == nil {
+				return nil
+			}
+			if reflect.DeepEqual(in, reflect.Zero(reflect.TypeOf(in)).Interface()) {
+				return nil
+			}
+			return func(in interface{}) *v1.Duration {
+				if in == nil {
+					return nil
+				}
+				if _, ok := in.([]interface{}); ok && len(in.([]interface{})) == 0 {
+					return nil
+				}
+				return func(in v1.Duration) *v1.Duration {
+					return &in
+				}(ExpandDuration(in))
+			}(in)
+		}(in["post_drain_delay"]),
+	}
+}
+
+func FlattenResourceInstanceGroupDeleteOptionsInto(in utils.InstanceGroupDeleteOptions, out map[string]interface{}) {
+	out["skip_drain"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.SkipDrain)
+	out["fail_on_drain_error"] = func(in bool) interface{} {
+		return FlattenBool(bool(in))
+	}(in.FailOnDrainError)
+	out["post_drain_delay"] = func(in *v1.Duration) interface{} {
+		return func(in *v1.Duration) interface{} {
+			if in == nil {
+				return nil
+			}
+			return func(in v1.Duration) interface{} {
+				return FlattenDuration(in)
+			}(*in)
+		}(in)
+	}(in.PostDrainDelay)
+}
+
+func FlattenResourceInstanceGroupDeleteOptions(in utils.InstanceGroupDeleteOptions) map[string]interface{} {
+	out := map[string]interface{}{}
+	FlattenResourceInstanceGroupDeleteOptionsInto(in, out)
+	return out
+}
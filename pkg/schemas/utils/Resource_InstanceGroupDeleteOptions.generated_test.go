@@ -0,0 +1,171 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/eddycharly/terraform-provider-kops/pkg/api/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandResourceInstanceGroupDeleteOptions(t *testing.T) {
+	_default := utils.InstanceGroupDeleteOptions{}
+	type args struct {
+		in map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want utils.InstanceGroupDeleteOptions
+	}{
+		{
+			name: "default",
+			args: args{
+				in: map[string]interface{}{
+					"skip_drain":          false,
+					"fail_on_drain_error": false,
+					"post_drain_delay":    nil,
+				},
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandResourceInstanceGroupDeleteOptions(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ExpandResourceInstanceGroupDeleteOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceInstanceGroupDeleteOptionsInto(t *testing.T) {
+	_default := map[string]interface{}{
+		"skip_drain":          false,
+		"fail_on_drain_error": false,
+		"post_drain_delay":    nil,
+	}
+	type args struct {
+		in utils.InstanceGroupDeleteOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupDeleteOptions{},
+			},
+			want: _default,
+		},
+		{
+			name: "SkipDrain - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.SkipDrain = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FailOnDrainError - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.FailOnDrainError = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PostDrainDelay - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.PostDrainDelay = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]interface{}{}
+			FlattenResourceInstanceGroupDeleteOptionsInto(tt.args.in, got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceInstanceGroupDeleteOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenResourceInstanceGroupDeleteOptions(t *testing.T) {
+	_default := map[string]interface{}{
+		"skip_drain":          false,
+		"fail_on_drain_error": false,
+		"post_drain_delay":    nil,
+	}
+	type args struct {
+		in utils.InstanceGroupDeleteOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "default",
+			args: args{
+				in: utils.InstanceGroupDeleteOptions{},
+			},
+			want: _default,
+		},
+		{
+			name: "SkipDrain - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.SkipDrain = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "FailOnDrainError - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.FailOnDrainError = false
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "PostDrainDelay - default",
+			args: args{
+				in: func() utils.InstanceGroupDeleteOptions {
+					subject := utils.InstanceGroupDeleteOptions{}
+					subject.PostDrainDelay = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenResourceInstanceGroupDeleteOptions(tt.args.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FlattenResourceInstanceGroupDeleteOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -141,6 +141,30 @@ func ExpandResourceRollingUpdateOptions(in map[string]interface{}) utils.Rolling
 		Force: func(in interface{}) bool {
 			return bool(ExpandBool(in))
 		}(in["force"]),
+		InstanceGroups: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["instance_groups"]),
+		InstanceGroupRoles: func(in interface{}) []string {
+			return func(in interface{}) []string {
+				if in == nil {
+					return nil
+				}
+				var out []string
+				for _, in := range in.([]interface{}) {
+					out = append(out, string(ExpandString(in)))
+				}
+				return out
+			}(in)
+		}(in["instance_group_roles"]),
 	}
 }
 
@@ -217,6 +241,24 @@ func FlattenResourceRollingUpdateOptionsInto(in utils.RollingUpdateOptions, out
 	out["force"] = func(in bool) interface{} {
 		return FlattenBool(bool(in))
 	}(in.Force)
+	out["instance_groups"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroups)
+	out["instance_group_roles"] = func(in []string) interface{} {
+		return func(in []string) []interface{} {
+			var out []interface{}
+			for _, in := range in {
+				out = append(out, FlattenString(string(in)))
+			}
+			return out
+		}(in)
+	}(in.InstanceGroupRoles)
 }
 
 func FlattenResourceRollingUpdateOptions(in utils.RollingUpdateOptions) map[string]interface{} {
@@ -21,16 +21,18 @@ func TestExpandResourceRollingUpdateOptions(t *testing.T) {
 			name: "default",
 			args: args{
 				in: map[string]interface{}{
-					"master_interval":     nil,
-					"node_interval":       nil,
-					"bastion_interval":    nil,
-					"fail_on_drain_error": false,
-					"fail_on_validate":    false,
-					"post_drain_delay":    nil,
-					"validation_timeout":  nil,
-					"validate_count":      nil,
-					"cloud_only":          false,
-					"force":               false,
+					"master_interval":      nil,
+					"node_interval":        nil,
+					"bastion_interval":     nil,
+					"fail_on_drain_error":  false,
+					"fail_on_validate":     false,
+					"post_drain_delay":     nil,
+					"validation_timeout":   nil,
+					"validate_count":       nil,
+					"cloud_only":           false,
+					"force":                false,
+					"instance_groups":      func() []interface{} { return nil }(),
+					"instance_group_roles": func() []interface{} { return nil }(),
 				},
 			},
 			want: _default,
@@ -48,16 +50,18 @@ func TestExpandResourceRollingUpdateOptions(t *testing.T) {
 
 func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 	_default := map[string]interface{}{
-		"master_interval":     nil,
-		"node_interval":       nil,
-		"bastion_interval":    nil,
-		"fail_on_drain_error": false,
-		"fail_on_validate":    false,
-		"post_drain_delay":    nil,
-		"validation_timeout":  nil,
-		"validate_count":      nil,
-		"cloud_only":          false,
-		"force":               false,
+		"master_interval":      nil,
+		"node_interval":        nil,
+		"bastion_interval":     nil,
+		"fail_on_drain_error":  false,
+		"fail_on_validate":     false,
+		"post_drain_delay":     nil,
+		"validation_timeout":   nil,
+		"validate_count":       nil,
+		"cloud_only":           false,
+		"force":                false,
+		"instance_groups":      func() []interface{} { return nil }(),
+		"instance_group_roles": func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in utils.RollingUpdateOptions
@@ -184,6 +188,28 @@ func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateOptions {
+					subject := utils.RollingUpdateOptions{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() utils.RollingUpdateOptions {
+					subject := utils.RollingUpdateOptions{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -198,16 +224,18 @@ func TestFlattenResourceRollingUpdateOptionsInto(t *testing.T) {
 
 func TestFlattenResourceRollingUpdateOptions(t *testing.T) {
 	_default := map[string]interface{}{
-		"master_interval":     nil,
-		"node_interval":       nil,
-		"bastion_interval":    nil,
-		"fail_on_drain_error": false,
-		"fail_on_validate":    false,
-		"post_drain_delay":    nil,
-		"validation_timeout":  nil,
-		"validate_count":      nil,
-		"cloud_only":          false,
-		"force":               false,
+		"master_interval":      nil,
+		"node_interval":        nil,
+		"bastion_interval":     nil,
+		"fail_on_drain_error":  false,
+		"fail_on_validate":     false,
+		"post_drain_delay":     nil,
+		"validation_timeout":   nil,
+		"validate_count":       nil,
+		"cloud_only":           false,
+		"force":                false,
+		"instance_groups":      func() []interface{} { return nil }(),
+		"instance_group_roles": func() []interface{} { return nil }(),
 	}
 	type args struct {
 		in utils.RollingUpdateOptions
@@ -334,6 +362,28 @@ func TestFlattenResourceRollingUpdateOptions(t *testing.T) {
 			},
 			want: _default,
 		},
+		{
+			name: "InstanceGroups - default",
+			args: args{
+				in: func() utils.RollingUpdateOptions {
+					subject := utils.RollingUpdateOptions{}
+					subject.InstanceGroups = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
+		{
+			name: "InstanceGroupRoles - default",
+			args: args{
+				in: func() utils.RollingUpdateOptions {
+					subject := utils.RollingUpdateOptions{}
+					subject.InstanceGroupRoles = nil
+					return subject
+				}(),
+			},
+			want: _default,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -83,6 +84,11 @@ func ForceNew(s *schema.Schema) *schema.Schema {
 	return s
 }
 
+func StringIn(values []string, s *schema.Schema) *schema.Schema {
+	s.ValidateFunc = validation.StringInSlice(values, false)
+	return s
+}
+
 func SuppressDiff(s *schema.Schema) *schema.Schema {
 	s.DiffSuppressFunc = func(_, _, _ string, _ *schema.ResourceData) bool {
 		return true
@@ -39,6 +39,7 @@ import (
 )
 
 type clusterValidatorImpl struct {
+	ctx            context.Context
 	cluster        *kops.Cluster
 	cloud          fi.Cloud
 	instanceGroups []*kops.InstanceGroup
@@ -70,7 +71,7 @@ func hasPlaceHolderIP(config *rest.Config) (bool, error) {
 	return false, nil
 }
 
-func NewClusterValidator(cluster *kops.Cluster, cloud fi.Cloud, instanceGroupList *kops.InstanceGroupList, config *rest.Config, k8sClient kubernetes.Interface) (kopsValidation.ClusterValidator, error) {
+func NewClusterValidator(ctx context.Context, cluster *kops.Cluster, cloud fi.Cloud, instanceGroupList *kops.InstanceGroupList, config *rest.Config, k8sClient kubernetes.Interface) (kopsValidation.ClusterValidator, error) {
 	var instanceGroups []*kops.InstanceGroup
 
 	for i := range instanceGroupList.Items {
@@ -83,6 +84,7 @@ func NewClusterValidator(cluster *kops.Cluster, cloud fi.Cloud, instanceGroupLis
 	}
 
 	return &clusterValidatorImpl{
+		ctx:            ctx,
 		cluster:        cluster,
 		cloud:          cloud,
 		instanceGroups: instanceGroups,
@@ -92,7 +94,7 @@ func NewClusterValidator(cluster *kops.Cluster, cloud fi.Cloud, instanceGroupLis
 }
 
 func (v *clusterValidatorImpl) Validate() (*kopsValidation.ValidationCluster, error) {
-	ctx := context.TODO()
+	ctx := v.ctx
 
 	clusterName := v.cluster.Name
 
@@ -188,7 +190,7 @@ func collectPodFailures(ctx context.Context, v *kopsValidation.ValidationCluster
 
 	err := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
 		return client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, opts)
-	})).EachListItem(context.TODO(), metav1.ListOptions{}, func(obj runtime.Object) error {
+	})).EachListItem(ctx, metav1.ListOptions{}, func(obj runtime.Object) error {
 		pod := obj.(*v1.Pod)
 
 		app := pod.GetLabels()["k8s-app"]
@@ -0,0 +1,81 @@
+resource "kops_cluster" "cluster" {
+  name                  = local.clusterName
+  admin_ssh_key         = file("${path.module}/../id_rsa.pub")
+  cloud_provider        = "gce"
+  kubernetes_version    = "1.19.12"
+  dns_zone              = local.dnsZone
+  project               = local.project
+  ssh_access            = ["0.0.0.0/0"]
+  kubernetes_api_access = ["0.0.0.0/0"]
+
+  iam {
+    allow_container_registry = true
+  }
+
+  cloud_config {
+    gce_service_account = "default"
+  }
+
+  networking {
+    cni {}
+  }
+
+  topology {
+    masters = "public"
+    nodes   = "public"
+    dns {
+      type = "Public"
+    }
+  }
+
+  subnet {
+    name   = local.region
+    type   = "Public"
+    region = local.region
+  }
+
+  # etcd clusters
+  etcd_cluster {
+    name = "main"
+    member {
+      name           = "master-0"
+      instance_group = "master-0"
+    }
+  }
+  etcd_cluster {
+    name = "events"
+    member {
+      name           = "master-0"
+      instance_group = "master-0"
+    }
+  }
+  kubelet {
+    anonymous_auth {
+      value = false
+    }
+  }
+}
+
+resource "kops_instance_group" "master-0" {
+  cluster_name = kops_cluster.cluster.id
+  name         = "master-0"
+  role         = "Master"
+  min_size     = 1
+  max_size     = 1
+  machine_type = local.masterType
+  image        = local.image
+  subnets      = [local.region]
+  zones        = [local.zone]
+}
+
+resource "kops_instance_group" "node-0" {
+  cluster_name = kops_cluster.cluster.id
+  name         = "node-0"
+  role         = "Node"
+  min_size     = 1
+  max_size     = 2
+  machine_type = local.nodeType
+  image        = local.image
+  subnets      = [local.region]
+  zones        = [local.zone]
+}
@@ -0,0 +1,10 @@
+locals {
+  masterType  = "n1-standard-1"
+  nodeType    = "n1-standard-2"
+  image       = "cos-cloud/cos-stable-57-9202-64-0"
+  clusterName = "cluster.example.com"
+  dnsZone     = "example.com"
+  project     = "testproject"
+  region      = "us-test1"
+  zone        = "us-test1-a"
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    kops = {
+      source  = "github/eddycharly/kops"
+      version = "0.0.1"
+    }
+  }
+}
+
+provider "kops" {
+  state_store = "file://./store/"
+  mock        = true
+  gce {
+    project = local.project
+    region  = local.region
+  }
+}
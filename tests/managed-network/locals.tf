@@ -0,0 +1,13 @@
+locals {
+  masterType  = "t3.medium"
+  nodeType    = "t3.medium"
+  clusterName = "cluster.example.com"
+  dnsZone     = "example.com"
+  networkCidr = "10.0.0.0/16"
+  privateSubnets = [
+    { cidr = "10.0.32.0/19", zone = "us-test-1a" }
+  ]
+  utilitySubnets = [
+    { cidr = "10.0.0.0/22", zone = "us-test-1a" }
+  ]
+}